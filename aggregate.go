@@ -0,0 +1,146 @@
+package loggo
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxAggregatedMessages is the maximum number of distinct messages an aggregation tracks in a window. Beyond it, the
+// entries are still counted, but their messages cannot become the top one.
+const maxAggregatedMessages = 1000
+
+// aggregation counts the entries of a level over a window, to log a summary of them at the end of each window.
+type aggregation struct {
+	level    Level
+	window   time.Duration
+	suppress bool
+
+	mu       sync.Mutex
+	count    int
+	messages map[string]int
+	done     chan struct{}
+	stopped  chan struct{}
+}
+
+// add counts the entry, if it has the level of the aggregation, and reports whether it must be suppressed.
+func (a *aggregation) add(entry Entry) bool {
+	if entry.Level != a.level {
+		return false
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.count++
+
+	if _, ok := a.messages[entry.Message]; ok || len(a.messages) < maxAggregatedMessages {
+		a.messages[entry.Message]++
+	}
+
+	return a.suppress
+}
+
+// reset returns the summary of the current window, e.g. "42 errors in the last 1m (top: 'db timeout' x31)", and
+// starts a new window. It returns false if no entry was counted in the window.
+func (a *aggregation) reset() (string, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.count == 0 {
+		return "", false
+	}
+
+	var (
+		top      string
+		topCount int
+	)
+
+	for message, n := range a.messages {
+		if n > topCount || n == topCount && message < top {
+			top, topCount = message, n
+		}
+	}
+
+	var b strings.Builder
+
+	b.WriteString(strconv.Itoa(a.count))
+	b.WriteByte(' ')
+	b.WriteString(strings.ToLower(a.level.String()))
+
+	if a.count > 1 {
+		b.WriteByte('s')
+	}
+
+	b.WriteString(" in the last ")
+	b.WriteString(formatDuration(a.window))
+	b.WriteString(" (top: '")
+	b.WriteString(top)
+	b.WriteString("' x")
+	b.WriteString(strconv.Itoa(topCount))
+	b.WriteByte(')')
+
+	a.count = 0
+	a.messages = map[string]int{}
+
+	return b.String(), true
+}
+
+// startAggregations starts logging the summaries of the aggregations of the logger at the end of each of their
+// windows. The aggregations inherited from a parent logger are already started.
+func (l *Logger) startAggregations() {
+	for _, a := range l.aggregations {
+		if a.done != nil {
+			continue
+		}
+
+		a.messages = map[string]int{}
+		a.done = make(chan struct{})
+		a.stopped = make(chan struct{})
+
+		go l.aggregate(a, l.clock.NewTicker(a.window))
+	}
+}
+
+// stopAggregations stops the aggregations, logging the summaries of their last window to the logger.
+func (l *Logger) stopAggregations(aggregations []*aggregation) error {
+	var err error
+
+	for _, a := range aggregations {
+		close(a.done)
+		<-a.stopped
+
+		if summaryErr := l.logAggregation(a); err == nil {
+			err = summaryErr
+		}
+	}
+
+	return err
+}
+
+// aggregate logs the summary of the aggregation at every tick, until it is stopped.
+func (l *Logger) aggregate(a *aggregation, ticker Ticker) {
+	defer close(a.stopped)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C():
+			_ = l.logAggregation(a)
+		case <-a.done:
+			return
+		}
+	}
+}
+
+// logAggregation logs the summary of the current window of the aggregation, if any entry was counted in it.
+// The summary is written directly, without going through the hooks, filters and aggregations of the logger.
+func (l *Logger) logAggregation(a *aggregation) error {
+	summary, ok := a.reset()
+	if !ok {
+		return nil
+	}
+
+	return l.write(newSummaryEntry(a.level, summary, l))
+}
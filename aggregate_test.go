@@ -0,0 +1,93 @@
+package loggo_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+func TestWithAggregation(t *testing.T) {
+	type testCase struct {
+		name     string
+		suppress bool
+		want     []string
+	}
+
+	testCases := []testCase{
+		{
+			name:     "suppress",
+			suppress: true,
+			want: []string{
+				"[INFO] started",
+				"[ERROR] 3 errors in the last 1m (top: 'db timeout' x2)",
+				"[ERROR] 1 error in the last 1m (top: 'disk full' x1)",
+			},
+		},
+		{
+			name: "keep",
+			want: []string{
+				"[INFO] started",
+				"[ERROR] db timeout",
+				"[ERROR] db timeout",
+				"[ERROR] cache miss",
+				"[ERROR] 3 errors in the last 1m (top: 'db timeout' x2)",
+				"[ERROR] disk full",
+				"[ERROR] 1 error in the last 1m (top: 'disk full' x1)",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := &loggotest.Recorder{}
+			observer := loggotest.NewObserver()
+			logger, clock := loggotest.New(loggo.LevelInfo,
+				loggo.WithOutput(recorder),
+				loggo.WithSink(observer),
+				loggo.WithTemplate("[{{.Level}}] {{.Message}}"),
+				loggo.WithAggregation(loggo.LevelError, time.Minute, tc.suppress),
+			)
+
+			logger.Info("started")
+			logger.Error("db timeout")
+			logger.Error("db timeout")
+			logger.Child().Error("cache miss")
+
+			clock.Advance(time.Minute)
+			waitLogged(recorder, "3 errors")
+
+			// An empty window logs no summary.
+			clock.Advance(time.Minute)
+			logger.Error("disk full")
+
+			if err := logger.Close(); err != nil {
+				t.Errorf("Logger.Close() error = %v", err)
+			}
+
+			if got := recorder.All(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("output = %q, want %q", got, tc.want)
+			}
+
+			for _, entry := range observer.Entries() {
+				if strings.Contains(entry.Message, "in the last") && (entry.Caller != "unknown" || entry.Function != "unknown") {
+					t.Errorf("summary caller = %q, %q, want an unknown caller", entry.Caller, entry.Function)
+				}
+			}
+		})
+	}
+}
+
+// waitLogged waits, for up to a second, until the recorder has recorded a line containing the text.
+func waitLogged(recorder *loggotest.Recorder, text string) {
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		for _, line := range recorder.All() {
+			if strings.Contains(line, text) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package loggo
+
+import (
+	"sync"
+	"time"
+)
+
+// Alert describes the rate of the entries of a level exceeding the limit of an alert configured with WithAlert.
+type Alert struct {
+	Level  Level         // Level of the entries
+	Limit  int           // Maximum number of entries in the window
+	Window time.Duration // Duration of the sliding window
+	Entry  Entry         // Entry that made the rate exceed the limit
+}
+
+// AlertFunc is a function called when an alert configured with WithAlert is triggered.
+type AlertFunc func(alert Alert)
+
+// alert watches the rate of the entries of a level over a sliding window.
+type alert struct {
+	level    Level
+	limit    int
+	window   time.Duration
+	callback AlertFunc
+
+	mu    sync.Mutex
+	times []time.Time // Times of the most recent entries in the window, at most limit+1
+	fired bool
+}
+
+// observe records the entry, if it has the level of the alert, and reports whether it triggers the alert: the rate
+// exceeds the limit, and did not since it was last triggered.
+func (a *alert) observe(entry Entry) bool {
+	if entry.Level != a.level {
+		return false
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	start := entry.Time.Add(-a.window)
+	kept := a.times[:0]
+
+	for _, t := range a.times {
+		if t.After(start) {
+			kept = append(kept, t)
+		}
+	}
+
+	a.times = append(kept, entry.Time)
+	if len(a.times) > a.limit+1 {
+		a.times = a.times[len(a.times)-a.limit-1:]
+	}
+
+	if len(a.times) <= a.limit {
+		a.fired = false
+
+		return false
+	}
+
+	if a.fired {
+		return false
+	}
+
+	a.fired = true
+
+	return true
+}
+
+// alert records the entry in the alerts of the logger, calling the ones it triggers.
+func (l *Logger) alert(entry Entry) {
+	for _, a := range l.alerts {
+		if a.observe(entry) {
+			a.callback(Alert{Level: a.level, Limit: a.limit, Window: a.window, Entry: entry})
+		}
+	}
+}
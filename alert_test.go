@@ -0,0 +1,51 @@
+package loggo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+func TestWithAlert(t *testing.T) {
+	var alerts []loggo.Alert
+
+	logger, clock := loggotest.New(loggo.LevelInfo,
+		loggo.WithOutput(&loggotest.Recorder{}),
+		loggo.WithAlert(loggo.LevelError, 2, time.Minute, func(a loggo.Alert) {
+			alerts = append(alerts, a)
+		}),
+	)
+
+	logger.Error("first")
+	logger.Warn("not watched")
+	clock.Advance(30 * time.Second)
+	logger.Error("second")
+
+	if len(alerts) != 0 {
+		t.Fatalf("alerts = %d within the limit, want 0", len(alerts))
+	}
+
+	clock.Advance(10 * time.Second)
+	logger.Error("third")
+	logger.Error("fourth")
+
+	if len(alerts) != 1 {
+		t.Fatalf("alerts = %d after exceeding the limit, want 1", len(alerts))
+	}
+
+	if a := alerts[0]; a.Level != loggo.LevelError || a.Limit != 2 || a.Window != time.Minute || a.Entry.Message != "third" {
+		t.Errorf("alert = %+v, want the third error", a)
+	}
+
+	// The rate drops back within the limit, re-arming the alert.
+	clock.Advance(2 * time.Minute)
+	logger.Error("fifth")
+	logger.Error("sixth")
+	logger.Error("seventh")
+
+	if len(alerts) != 2 || alerts[1].Entry.Message != "seventh" {
+		t.Errorf("alerts = %+v, want a second alert on the seventh error", alerts)
+	}
+}
@@ -0,0 +1,240 @@
+package loggo
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+)
+
+// Batch buffers a group of related log messages, so they are written contiguously when flushed, without being
+// interleaved with the messages logged concurrently by other goroutines. It is safe for concurrent use.
+//
+// Messages go through the pre-hooks and Threshold of the Logger when they are added to the Batch, and are written to
+// its output and sinks, followed by its post-hooks, when the Batch is flushed, e.g. by the Fatal and Panic methods
+// before exiting or panicking. Messages never flushed are discarded.
+type Batch struct {
+	logger   *Logger
+	mu       sync.Mutex
+	buf      bytes.Buffer
+	entries  []Entry
+	ends     []int
+	messages []string
+	err      error
+}
+
+// Batch creates a new, empty Batch of messages for the Logger.
+//
+// Returns:
+//   - A pointer to the newly created Batch.
+//
+// Example:
+//
+//	b := logger.Batch()
+//	b.Info("Starting migration")
+//	b.Error("Migration failed")
+//	err := b.Flush()
+func (l *Logger) Batch() *Batch {
+	return &Batch{logger: l}
+}
+
+// Log adds a message at the given log level to the Batch.
+//
+// Parameters:
+//   - level: The log level of the message.
+//   - message: The message to log.
+func (b *Batch) Log(level Level, message string) {
+	b.log(level, text(message))
+}
+
+// Logf adds a formatted message at the given log level to the Batch.
+//
+// Parameters:
+//   - level: The log level of the message.
+//   - format: The format string for the message.
+//   - args: The arguments for the format string.
+func (b *Batch) Logf(level Level, format string, args ...any) {
+	b.log(level, sprintf(format, args))
+}
+
+// Trace adds a message at the LevelTrace to the Batch.
+func (b *Batch) Trace(message string) {
+	b.log(LevelTrace, text(message))
+}
+
+// Tracef adds a formatted message at the LevelTrace to the Batch.
+func (b *Batch) Tracef(format string, args ...any) {
+	b.log(LevelTrace, sprintf(format, args))
+}
+
+// Debug adds a message at the LevelDebug to the Batch.
+func (b *Batch) Debug(message string) {
+	b.log(LevelDebug, text(message))
+}
+
+// Debugf adds a formatted message at the LevelDebug to the Batch.
+func (b *Batch) Debugf(format string, args ...any) {
+	b.log(LevelDebug, sprintf(format, args))
+}
+
+// Info adds a message at the LevelInfo to the Batch.
+func (b *Batch) Info(message string) {
+	b.log(LevelInfo, text(message))
+}
+
+// Infof adds a formatted message at the LevelInfo to the Batch.
+func (b *Batch) Infof(format string, args ...any) {
+	b.log(LevelInfo, sprintf(format, args))
+}
+
+// Warn adds a message at the LevelWarn to the Batch.
+func (b *Batch) Warn(message string) {
+	b.log(LevelWarn, text(message))
+}
+
+// Warnf adds a formatted message at the LevelWarn to the Batch.
+func (b *Batch) Warnf(format string, args ...any) {
+	b.log(LevelWarn, sprintf(format, args))
+}
+
+// Error adds a message at the LevelError to the Batch.
+func (b *Batch) Error(message string) {
+	b.log(LevelError, text(message))
+}
+
+// Errorf adds a formatted message at the LevelError to the Batch.
+func (b *Batch) Errorf(format string, args ...any) {
+	b.log(LevelError, sprintf(format, args))
+}
+
+// Panic adds a message at the LevelPanic to the Batch, then flushes the Batch and panics with the message, like
+// Logger.Panic.
+func (b *Batch) Panic(message string) {
+	b.log(LevelPanic, text(message))
+	b.panicMessage(message)
+}
+
+// Panicf adds a formatted message at the LevelPanic to the Batch, then flushes the Batch and panics with the message,
+// like Logger.Panicf.
+func (b *Batch) Panicf(format string, args ...any) {
+	message := fmt.Sprintf(format, args...)
+	b.log(LevelPanic, text(message))
+	b.panicMessage(message)
+}
+
+// Fatal adds a message at the LevelFatal to the Batch, then flushes the Batch and exits the process, like Logger.Fatal.
+func (b *Batch) Fatal(message string) {
+	b.log(LevelFatal, text(message))
+	b.exitFatal()
+}
+
+// Fatalf adds a formatted message at the LevelFatal to the Batch, then flushes the Batch and exits the process, like
+// Logger.Fatalf.
+func (b *Batch) Fatalf(format string, args ...any) {
+	b.log(LevelFatal, sprintf(format, args))
+	b.exitFatal()
+}
+
+// Flush writes the buffered messages to the output and sinks of the Logger, under a single lock acquisition, and
+// empties the Batch.
+//
+// Returns:
+//   - An error if a message could not be rendered or written, nil otherwise.
+func (b *Batch) Flush() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	defer func() {
+		b.buf.Reset()
+		b.entries = nil
+		b.ends = nil
+		b.messages = nil
+		b.err = nil
+	}()
+
+	if b.err != nil {
+		b.logger.reportError(b.err)
+
+		return b.err
+	}
+
+	if len(b.entries) == 0 {
+		return nil
+	}
+
+	if err := b.logger.emit(b.buf.Bytes(), b.ends, b.entries...); err != nil {
+		b.logger.reportError(err)
+
+		return err
+	}
+
+	for i := range b.messages {
+		for _, hook := range b.logger.postHooks {
+			hook(b.logger, &b.messages[i])
+		}
+	}
+
+	return nil
+}
+
+// panicMessage flushes the Batch and panics with the message, unless its Logger is a copy returned by If with a false
+// condition.
+func (b *Batch) panicMessage(message string) {
+	if b.logger.disabled {
+		return
+	}
+
+	_ = b.Flush()
+	panic(message)
+}
+
+// exitFatal flushes the Batch, then exits the process like the Fatal methods of its Logger, unless they do not exit.
+func (b *Batch) exitFatal() {
+	if b.logger.exit == nil || b.logger.disabled {
+		return
+	}
+
+	_ = b.Flush()
+	b.logger.exitFatal()
+}
+
+// log adds a message at the given log level to the Batch. Every exported method must call it directly, so the
+// caller information is always at the same stack depth.
+func (b *Batch) log(level Level, msg message) {
+	l := b.logger
+	l.checkOpen()
+	if l.off() || l.discards(level) {
+		return
+	}
+
+	message := msg.String()
+	for _, hook := range l.preHooks {
+		hook(l, &message)
+	}
+
+	level = l.escalate(l.Context, level)
+	if level.Rank() < l.GetThreshold().Rank() {
+		return
+	}
+
+	entry, ok := l.accept(newEntry(level, message, l))
+	if !ok {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.err != nil {
+		return
+	}
+
+	if err := l.render(&b.buf, entry); err != nil {
+		b.err = err
+
+		return
+	}
+
+	b.entries = append(b.entries, entry)
+	b.ends = append(b.ends, b.buf.Len())
+	b.messages = append(b.messages, message)
+}
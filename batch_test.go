@@ -0,0 +1,131 @@
+package loggo_test
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+// writesRecorder records each call to Write separately.
+type writesRecorder struct {
+	mu     sync.Mutex
+	writes []string
+}
+
+func (w *writesRecorder) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.writes = append(w.writes, string(p))
+
+	return len(p), nil
+}
+
+func TestBatch_Flush(t *testing.T) {
+	w := &writesRecorder{}
+	observer := loggotest.NewObserver()
+
+	var posted []string
+
+	logger := loggo.New(
+		loggo.LevelInfo,
+		loggo.WithOutput(w),
+		loggo.WithSink(observer),
+		loggo.WithTemplate("[{{.Level}}] {{.Message}} {{.Caller}}"),
+		loggo.WithCallerFormat(loggo.CallerFormatBase),
+		loggo.WithPostHook(func(l *loggo.Logger, msg *string) { posted = append(posted, *msg) }),
+		loggo.WithExitFunc(nil),
+	)
+
+	b := logger.Batch()
+
+	_, _, line, _ := runtime.Caller(0)
+	b.Log(loggo.LevelInfo, "log")
+	b.Logf(loggo.LevelInfo, "%s", "logf")
+	b.Debug("debug")
+	b.Debugf("%s", "debugf")
+	b.Info("info")
+	b.Infof("%s", "infof")
+	b.Warn("warn")
+	b.Warnf("%s", "warnf")
+	b.Error("error")
+	b.Errorf("%s", "errorf")
+	b.Fatal("fatal")
+	b.Fatalf("%s", "fatalf")
+
+	if len(w.writes) != 0 {
+		t.Fatalf("Batch wrote before Flush: %q", w.writes)
+	}
+
+	if err := b.Flush(); err != nil {
+		t.Fatalf("Batch.Flush() error = %v", err)
+	}
+
+	var want strings.Builder
+
+	messages := []string{"log", "logf", "debug", "debugf", "info", "infof", "warn", "warnf", "error", "errorf", "fatal", "fatalf"}
+	levels := []string{"INFO", "INFO", "", "", "INFO", "INFO", "WARN", "WARN", "ERROR", "ERROR", "FATAL", "FATAL"}
+
+	for i, message := range messages {
+		if levels[i] != "" {
+			want.WriteString(fmt.Sprintf("[%s] %s batch_test.go:%d\n", levels[i], message, line+1+i))
+		}
+	}
+
+	if len(w.writes) != 1 || w.writes[0] != want.String() {
+		t.Errorf("Batch.Flush() writes = %q, want a single %q", w.writes, want.String())
+	}
+
+	if got := len(observer.Entries()); got != 10 {
+		t.Errorf("Batch.Flush() sink entries = %d, want 10", got)
+	}
+
+	if len(posted) != 10 || posted[0] != "log" {
+		t.Errorf("Batch.Flush() post-hooks = %q, want 10 messages", posted)
+	}
+
+	if err := b.Flush(); err != nil || len(w.writes) != 1 {
+		t.Errorf("Batch.Flush() of an empty Batch = %v, writes = %d", err, len(w.writes))
+	}
+}
+
+func TestBatch_Flush_errors(t *testing.T) {
+	b := loggo.New(loggo.LevelInfo, loggo.WithTemplate("{{.Level")).Batch()
+	b.Info("first")
+	b.Info("second")
+
+	if err := b.Flush(); err == nil {
+		t.Error("Batch.Flush() error = nil, want a template error")
+	}
+
+	b = loggo.New(loggo.LevelInfo, loggo.WithOutput(errorWriter{})).Batch()
+	b.Info("first")
+
+	if err := b.Flush(); err == nil {
+		t.Error("Batch.Flush() error = nil, want a write error")
+	}
+
+	b = loggo.New(loggo.LevelInfo).If(false).Batch()
+	b.Info("discarded")
+
+	if err := b.Flush(); err != nil {
+		t.Errorf("Batch.Flush() error = %v, want nil", err)
+	}
+}
+
+func ExampleLogger_Batch() {
+	logger := loggo.New(loggo.LevelInfo, loggo.WithTimeProvider(fakeNow))
+
+	b := logger.Batch()
+	b.Info("Starting migration")
+	b.Errorf("Migration %d failed", 42)
+
+	_ = b.Flush()
+	// Output: 2022-01-25 00:00:00 [ INFO]: Starting migration
+	// 2022-01-25 00:00:00 [ERROR]: Migration 42 failed
+}
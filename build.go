@@ -0,0 +1,33 @@
+package loggo
+
+import (
+	"runtime/debug"
+)
+
+// Build describes the build of the binary emitting the log entries, as read from its embedded build information.
+type Build struct {
+	Revision  string `json:"revision,omitempty"`   // VCS revision the binary was built from, e.g. a git commit hash
+	Dirty     bool   `json:"dirty,omitempty"`      // Whether the working tree had uncommitted changes at build time
+	GoVersion string `json:"go_version,omitempty"` // Go version the binary was built with, e.g. "go1.23.0"
+}
+
+// readBuild returns the Build of the running binary. The Build is empty if the build information is not available.
+func readBuild() Build {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return Build{}
+	}
+
+	build := Build{GoVersion: info.GoVersion}
+
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			build.Revision = setting.Value
+		case "vcs.modified":
+			build.Dirty = setting.Value == "true"
+		}
+	}
+
+	return build
+}
@@ -0,0 +1,108 @@
+package loggo
+
+import (
+	"path"
+	"runtime"
+	"runtime/debug"
+	"strings"
+)
+
+// CallerFormat represents how the file path of the caller is rendered.
+type CallerFormat byte
+
+// Available caller formats.
+const (
+	// CallerFormatFull renders the full path of the file, e.g. "/home/user/app/internal/db/db.go:42".
+	CallerFormatFull CallerFormat = iota
+	// CallerFormatShort renders the last directory and the file name, e.g. "db/db.go:42".
+	CallerFormatShort
+	// CallerFormatBase renders only the file name, e.g. "db.go:42".
+	CallerFormatBase
+)
+
+// path returns the file path rendered in the caller format.
+func (f CallerFormat) path(file string) string {
+	switch f {
+	case CallerFormatShort:
+		if i := strings.LastIndexByte(file, '/'); i >= 0 {
+			if j := strings.LastIndexByte(file[:i], '/'); j >= 0 {
+				return file[j+1:]
+			}
+		}
+
+		return file
+	case CallerFormatBase:
+		if i := strings.LastIndexByte(file, '/'); i >= 0 {
+			return file[i+1:]
+		}
+
+		return file
+	default:
+		return file
+	}
+}
+
+// functionName returns the full name of the function at the program counter, including its import path, e.g.
+// "github.com/acme/app/db.(*Client).Query". It returns an empty string if the function cannot be found.
+func functionName(pc uintptr) string {
+	fn := runtime.FuncForPC(pc)
+	if fn == nil {
+		return ""
+	}
+
+	return fn.Name()
+}
+
+// shortFunctionName returns the function name without its import path, e.g. "db.(*Client).Query".
+// It returns "unknown" for an empty function name.
+func shortFunctionName(function string) string {
+	if function == "" {
+		return "unknown"
+	}
+
+	if i := strings.LastIndexByte(function, '/'); i >= 0 {
+		return function[i+1:]
+	}
+
+	return function
+}
+
+// packagePath returns the import path of the package of a full function name, e.g. "github.com/acme/app/db".
+func packagePath(function string) string {
+	slash := strings.LastIndexByte(function, '/')
+	if dot := strings.IndexByte(function[slash+1:], '.'); dot >= 0 {
+		return function[:slash+1+dot]
+	}
+
+	return function
+}
+
+// componentName returns the component of a full function name: its package path with the prefix trimmed, e.g.
+// "internal/payments". When the package path is the prefix itself, its last element is used.
+func componentName(function, prefix string) string {
+	if function == "" {
+		return "unknown"
+	}
+
+	pkg := packagePath(function)
+
+	if pkg == prefix {
+		return path.Base(pkg)
+	}
+
+	if prefix != "" && strings.HasPrefix(pkg, prefix+"/") {
+		return pkg[len(prefix)+1:]
+	}
+
+	return pkg
+}
+
+// mainModulePath returns the path of the main module of the running binary, or an empty string if it is unknown.
+func mainModulePath() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+
+	return info.Main.Path
+}
@@ -0,0 +1,211 @@
+package loggo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// catalog is the registry of the events, by code.
+var catalog = struct {
+	sync.RWMutex
+	events map[string]event
+}{events: map[string]event{}}
+
+// event is an event registered in the catalog.
+type event struct {
+	level   Level
+	message string
+}
+
+// Event registers an event in the catalog, so it can be logged by its code with Logger.Event, giving stable,
+// machine-readable codes for alerting and translation. The message can reference the fields of the logged entry
+// between braces, e.g. "user {id} locked out". Events are meant to be registered once, in package variables or init
+// functions: registering a code twice panics.
+//
+// Parameters:
+//   - code: The unique code of the event, e.g. "USR001".
+//   - level: The log level of the event.
+//   - message: The message of the event, with its placeholders.
+//
+// Example:
+//
+//	func init() {
+//		loggo.Event("USR001", loggo.LevelWarn, "user {id} locked out")
+//	}
+func Event(code string, level Level, message string) {
+	catalog.Lock()
+	defer catalog.Unlock()
+
+	if _, ok := catalog.events[code]; ok {
+		panic("loggo: event " + code + " registered twice")
+	}
+
+	catalog.events[code] = event{level: level, message: message}
+}
+
+// Event logs the event registered with the code, at its level, with the given fields. Its placeholders are replaced
+// with the values of the fields, and the code is attached first, as the "event" field. Codes not registered are
+// logged at LevelError, as "unknown event <code>".
+//
+// Parameters:
+//   - code: The code of the event.
+//   - fields: Variadic fields of the event.
+//
+// Example:
+//
+//	logger.Event("USR001", loggo.F("id", user.ID))
+//	// Output: 2024-09-03 15:04:05 [ WARN]: user 42 locked out event=USR001 id=42
+func (l *Logger) Event(code string, fields ...Field) {
+	catalog.RLock()
+	e, ok := catalog.events[code]
+	catalog.RUnlock()
+
+	fields = append(Fields{F("event", code)}, fields...)
+	if !ok {
+		_ = l.log(LevelError, text("unknown event "+code), fields...)
+
+		return
+	}
+
+	_ = l.log(e.level, text(expandMessage(e.message, fields)), fields...)
+}
+
+// EventInfo describes an event registered in the catalog.
+type EventInfo struct {
+	Code    string // Code of the event
+	Level   Level  // Log level of the event
+	Message string // Message of the event, with its placeholders
+}
+
+// Events returns the events registered in the catalog, sorted by code.
+//
+// Returns:
+//   - The registered events.
+//
+// Example:
+//
+//	for _, e := range loggo.Events() {
+//		fmt.Println(e.Code, e.Level, e.Message)
+//	}
+func Events() []EventInfo {
+	catalog.RLock()
+	defer catalog.RUnlock()
+
+	events := make([]EventInfo, 0, len(catalog.events))
+	for code, e := range catalog.events {
+		events = append(events, EventInfo{Code: code, Level: e.level, Message: e.message})
+	}
+
+	sort.Slice(events, func(i, j int) bool { return events[i].Code < events[j].Code })
+
+	return events
+}
+
+// WriteEventsJSON writes the events registered in the catalog, sorted by code, as a JSON array of objects with the
+// "code", "level" and "message" keys, so operations can maintain runbooks from the running code.
+//
+// Parameters:
+//   - w: The io.Writer to write the events to.
+//
+// Returns:
+//   - An error if the events could not be written, nil otherwise.
+//
+// Example:
+//
+//	http.HandleFunc("/debug/events", func(w http.ResponseWriter, _ *http.Request) {
+//		_ = loggo.WriteEventsJSON(w)
+//	})
+func WriteEventsJSON(w io.Writer) error {
+	type jsonEvent struct {
+		Code    string `json:"code"`
+		Level   string `json:"level"`
+		Message string `json:"message"`
+	}
+
+	events := []jsonEvent{}
+	for _, e := range Events() {
+		events = append(events, jsonEvent{Code: e.Code, Level: e.Level.String(), Message: e.Message})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(events); err != nil {
+		return errors.New("error writing events: " + err.Error())
+	}
+
+	return nil
+}
+
+// WriteEventsMarkdown writes the events registered in the catalog, sorted by code, as a Markdown table with the code,
+// level and message columns.
+//
+// Parameters:
+//   - w: The io.Writer to write the events to.
+//
+// Returns:
+//   - An error if the events could not be written, nil otherwise.
+//
+// Example:
+//
+//	file, _ := os.Create("EVENTS.md")
+//	defer file.Close()
+//	_ = loggo.WriteEventsMarkdown(file)
+func WriteEventsMarkdown(w io.Writer) error {
+	var b strings.Builder
+
+	b.WriteString("| Code | Level | Message |\n|------|-------|---------|\n")
+
+	for _, e := range Events() {
+		fmt.Fprintf(&b, "| %s | %s | %s |\n", markdownCell(e.Code), e.Level, markdownCell(e.Message))
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return errors.New("error writing events: " + err.Error())
+	}
+
+	return nil
+}
+
+// markdownCell escapes the text of a Markdown table cell.
+func markdownCell(s string) string {
+	return strings.NewReplacer("|", "\\|", "\n", " ").Replace(s)
+}
+
+// expandMessage replaces the placeholders of the message, e.g. "{id}", with the values of the fields of the same key.
+// Placeholders without a field are kept as is.
+func expandMessage(message string, fields Fields) string {
+	var b strings.Builder
+
+	for {
+		open := strings.IndexByte(message, '{')
+		if open < 0 {
+			break
+		}
+
+		end := strings.IndexByte(message[open:], '}')
+		if end < 0 {
+			break
+		}
+
+		end += open
+		b.WriteString(message[:open])
+
+		if value, ok := fields.Get(message[open+1 : end]); ok {
+			b.WriteString(fmt.Sprint(value))
+		} else {
+			b.WriteString(message[open : end+1])
+		}
+
+		message = message[end+1:]
+	}
+
+	b.WriteString(message)
+
+	return b.String()
+}
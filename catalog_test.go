@@ -0,0 +1,121 @@
+package loggo_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func init() {
+	loggo.Event("TST001", loggo.LevelWarn, "user {id} locked out after {attempts} attempts, {missing} {")
+	loggo.Event("TST003", loggo.LevelError, "payment | {order} failed")
+}
+
+func TestLogger_Event(t *testing.T) {
+	type testCase struct {
+		name   string
+		code   string
+		fields []loggo.Field
+		want   string
+	}
+
+	testCases := []testCase{
+		{
+			name:   "registered",
+			code:   "TST001",
+			fields: []loggo.Field{loggo.F("id", 42), loggo.F("attempts", 3)},
+			want:   "WARN user 42 locked out after 3 attempts, {missing} { event=TST001 id=42 attempts=3\n",
+		},
+		{
+			name:   "unknown",
+			code:   "TST999",
+			fields: []loggo.Field{loggo.F("id", 42)},
+			want:   "ERROR unknown event TST999 event=TST999 id=42\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Level}} {{.Message}} {{.Fields}}"))
+
+			logger.Event(tc.code, tc.fields...)
+
+			if w.String() != tc.want {
+				t.Errorf("output = %q, want %q", w.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestEvent_duplicate(t *testing.T) {
+	code := "TST" + strconv.FormatInt(time.Now().UnixNano(), 10)
+	loggo.Event(code, loggo.LevelInfo, "first")
+
+	defer func() {
+		if r, want := recover(), "loggo: event "+code+" registered twice"; r != want {
+			t.Errorf("recover() = %v, want %q", r, want)
+		}
+	}()
+
+	loggo.Event(code, loggo.LevelInfo, "second")
+}
+
+func TestEvents(t *testing.T) {
+	var index int
+
+	events := loggo.Events()
+	for i, e := range events {
+		if e.Code == "TST003" {
+			index = i
+		}
+
+		if i > 0 && events[i-1].Code >= e.Code {
+			t.Errorf("Events() = %v, want them sorted by code", events)
+		}
+	}
+
+	want := loggo.EventInfo{Code: "TST003", Level: loggo.LevelError, Message: "payment | {order} failed"}
+	if !reflect.DeepEqual(events[index], want) {
+		t.Errorf("Events() = %v, want them to contain %v", events, want)
+	}
+}
+
+func TestWriteEventsJSON(t *testing.T) {
+	w := &strings.Builder{}
+	if err := loggo.WriteEventsJSON(w); err != nil {
+		t.Fatalf("WriteEventsJSON() error = %v", err)
+	}
+
+	var events []map[string]string
+	if err := json.Unmarshal([]byte(w.String()), &events); err != nil {
+		t.Fatalf("WriteEventsJSON() = %s, not valid JSON: %v", w.String(), err)
+	}
+
+	want := map[string]string{"code": "TST003", "level": "ERROR", "message": "payment | {order} failed"}
+	for _, e := range events {
+		if e["code"] == want["code"] && !reflect.DeepEqual(e, want) {
+			t.Errorf("event = %v, want %v", e, want)
+		}
+	}
+}
+
+func TestWriteEventsMarkdown(t *testing.T) {
+	w := &strings.Builder{}
+	if err := loggo.WriteEventsMarkdown(w); err != nil {
+		t.Fatalf("WriteEventsMarkdown() error = %v", err)
+	}
+
+	if want := "| Code | Level | Message |\n|------|-------|---------|\n"; !strings.HasPrefix(w.String(), want) {
+		t.Errorf("WriteEventsMarkdown() = %q, want the %q header", w.String(), want)
+	}
+
+	if want := "| TST003 | ERROR | payment \\| {order} failed |\n"; !strings.Contains(w.String(), want) {
+		t.Errorf("WriteEventsMarkdown() = %q, want the %q row", w.String(), want)
+	}
+}
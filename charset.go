@@ -0,0 +1,84 @@
+package loggo
+
+import (
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Charset transcodes the UTF-8 log output to another character encoding, for downstream tooling that does not handle
+// plain UTF-8.
+type Charset interface {
+	// BOM returns the byte order mark of the encoding, written at the start of new files when enabled.
+	BOM() []byte
+	// Encode appends the encoding of the UTF-8 text in src to dst and returns the extended buffer.
+	Encode(dst, src []byte) []byte
+}
+
+// Available charsets.
+var (
+	// CharsetUTF8 keeps the output as UTF-8. It is the default.
+	CharsetUTF8 Charset = utf8Charset{}
+	// CharsetUTF16LE encodes the output as little-endian UTF-16, as expected by many Windows tools.
+	CharsetUTF16LE Charset = utf16LECharset{}
+	// CharsetLatin1 encodes the output as ISO-8859-1. Characters that cannot be represented are replaced by '?'.
+	CharsetLatin1 Charset = latin1Charset{}
+)
+
+// utf8Charset is the UTF-8 Charset.
+type utf8Charset struct{}
+
+// BOM returns the UTF-8 byte order mark.
+func (utf8Charset) BOM() []byte {
+	return []byte{0xEF, 0xBB, 0xBF}
+}
+
+// Encode appends src unchanged to dst.
+func (utf8Charset) Encode(dst, src []byte) []byte {
+	return append(dst, src...)
+}
+
+// utf16LECharset is the little-endian UTF-16 Charset.
+type utf16LECharset struct{}
+
+// BOM returns the little-endian UTF-16 byte order mark.
+func (utf16LECharset) BOM() []byte {
+	return []byte{0xFF, 0xFE}
+}
+
+// Encode appends the little-endian UTF-16 encoding of src to dst.
+func (utf16LECharset) Encode(dst, src []byte) []byte {
+	for len(src) > 0 {
+		r, size := utf8.DecodeRune(src)
+		src = src[size:]
+
+		for _, unit := range utf16.AppendRune(nil, r) {
+			dst = append(dst, byte(unit), byte(unit>>8))
+		}
+	}
+
+	return dst
+}
+
+// latin1Charset is the ISO-8859-1 Charset.
+type latin1Charset struct{}
+
+// BOM returns nil, as ISO-8859-1 has no byte order mark.
+func (latin1Charset) BOM() []byte {
+	return nil
+}
+
+// Encode appends the ISO-8859-1 encoding of src to dst.
+func (latin1Charset) Encode(dst, src []byte) []byte {
+	for len(src) > 0 {
+		r, size := utf8.DecodeRune(src)
+		src = src[size:]
+
+		if r > 0xFF {
+			r = '?'
+		}
+
+		dst = append(dst, byte(r))
+	}
+
+	return dst
+}
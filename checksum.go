@@ -0,0 +1,103 @@
+package loggo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"strconv"
+	"strings"
+)
+
+// Checksum represents the algorithm of the checksum appended to each log line.
+type Checksum byte
+
+// Available checksums.
+const (
+	// ChecksumNone appends no checksum.
+	ChecksumNone Checksum = iota
+	// ChecksumCRC32 appends the CRC-32 (IEEE) of the line, e.g. " crc32=1a2b3c4d".
+	ChecksumCRC32
+	// ChecksumCRC32C appends the CRC-32C (Castagnoli) of the line, e.g. " crc32c=1a2b3c4d".
+	ChecksumCRC32C
+)
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// key returns the key of the checksum in the log line.
+func (c Checksum) key() string {
+	switch c {
+	case ChecksumCRC32:
+		return "crc32"
+	case ChecksumCRC32C:
+		return "crc32c"
+	default:
+		return ""
+	}
+}
+
+// sum returns the checksum of the content.
+func (c Checksum) sum(content []byte) uint32 {
+	if c == ChecksumCRC32C {
+		return crc32.Checksum(content, castagnoli)
+	}
+
+	return crc32.ChecksumIEEE(content)
+}
+
+// appendChecksum appends the checksum of the content of buf, from start, to buf.
+func (c Checksum) appendChecksum(buf *bytes.Buffer, start int) {
+	fmt.Fprintf(buf, " %s=%08x", c.key(), c.sum(buf.Bytes()[start:]))
+}
+
+// VerifyChecksum verifies the checksum appended to a log line by a logger configured with WithChecksum.
+// The line ending, if any, is ignored.
+//
+// Parameters:
+//   - line: The log line to verify.
+//
+// Returns:
+//   - error: An error if the line has no checksum, or if the checksum does not match its content.
+//
+// Example:
+//
+//	if err := loggo.VerifyChecksum(line); err != nil {
+//		fmt.Println("corrupted line:", err)
+//	}
+func VerifyChecksum(line string) error {
+	line = strings.TrimRight(line, "\r\n")
+
+	i := strings.LastIndexByte(line, ' ')
+	if i < 0 {
+		return errors.New("missing checksum")
+	}
+
+	content, field := line[:i], line[i+1:]
+
+	key, value, ok := strings.Cut(field, "=")
+	if !ok {
+		return errors.New("missing checksum")
+	}
+
+	var checksum Checksum
+
+	switch key {
+	case ChecksumCRC32.key():
+		checksum = ChecksumCRC32
+	case ChecksumCRC32C.key():
+		checksum = ChecksumCRC32C
+	default:
+		return errors.New("missing checksum")
+	}
+
+	want, err := strconv.ParseUint(value, 16, 32)
+	if err != nil {
+		return errors.New("invalid checksum: " + value)
+	}
+
+	if got := checksum.sum([]byte(content)); got != uint32(want) {
+		return fmt.Errorf("checksum mismatch: got %08x, want %08x", got, want)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package loggo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestLogger_Log_checksum(t *testing.T) {
+	type testCase struct {
+		name     string
+		checksum loggo.Checksum
+		ending   loggo.LineEnding
+		want     string
+	}
+
+	testCases := []testCase{
+		{name: "None", checksum: loggo.ChecksumNone, ending: loggo.LineEndingLF, want: "hello\n"},
+		{name: "CRC32", checksum: loggo.ChecksumCRC32, ending: loggo.LineEndingLF, want: "hello crc32=3610a686\n"},
+		{name: "CRC32C", checksum: loggo.ChecksumCRC32C, ending: loggo.LineEndingCRLF, want: "hello crc32c=9a71bb4c\r\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Message}}"), loggo.WithChecksum(tc.checksum), loggo.WithLineEnding(tc.ending))
+			logger.Info("hello")
+
+			if w.String() != tc.want {
+				t.Errorf("Logger.Info() = %q, want %q", w.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	type testCase struct {
+		name    string
+		line    string
+		wantErr string
+	}
+
+	testCases := []testCase{
+		{name: "CRC32", line: "hello crc32=3610a686\n"},
+		{name: "CRC32C", line: "hello crc32c=9a71bb4c\r\n"},
+		{name: "Truncated", line: "hell crc32=3610a686", wantErr: "checksum mismatch: got 1c8600e3, want 3610a686"},
+		{name: "Missing", line: "hello", wantErr: "missing checksum"},
+		{name: "UnknownKey", line: "hello sum=3610a686", wantErr: "missing checksum"},
+		{name: "NoValue", line: "hello world", wantErr: "missing checksum"},
+		{name: "Invalid", line: "hello crc32=xyz", wantErr: "invalid checksum: xyz"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := loggo.VerifyChecksum(tc.line)
+			if tc.wantErr == "" && err != nil {
+				t.Errorf("VerifyChecksum() error = %v, want nil", err)
+			}
+
+			if tc.wantErr != "" && (err == nil || err.Error() != tc.wantErr) {
+				t.Errorf("VerifyChecksum() error = %v, want %q", err, tc.wantErr)
+			}
+		})
+	}
+}
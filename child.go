@@ -0,0 +1,182 @@
+package loggo
+
+import (
+	"io"
+	"sync/atomic"
+)
+
+// clone returns a copy of the logger sharing its output and output lock. The slices of the copy are clipped, so
+// appending to them never modifies the ones of the original logger, and its transformers are chained to the ones of
+// the original logger. The copy has its own threshold, unless the logger has a dynamic level, whose reloads must reach
+// the copy.
+func (l *Logger) clone() *Logger {
+	c := *l
+	c.parent = l
+	c.closed = &atomic.Bool{}
+	c.ownsOutput = false
+	c.ownsSplitOutput = false
+	c.preHooks = l.preHooks[:len(l.preHooks):len(l.preHooks)]
+	c.postHooks = l.postHooks[:len(l.postHooks):len(l.postHooks)]
+	c.sinks = l.sinks[:len(l.sinks):len(l.sinks)]
+	c.extraOutputs = l.extraOutputs[:len(l.extraOutputs):len(l.extraOutputs)]
+	c.filters = l.filters[:len(l.filters):len(l.filters)]
+	c.aggregations = l.aggregations[:len(l.aggregations):len(l.aggregations)]
+	c.alerts = l.alerts[:len(l.alerts):len(l.alerts)]
+	c.fields = l.fields[:len(l.fields):len(l.fields)]
+	c.fieldFuncs = l.fieldFuncs[:len(l.fieldFuncs):len(l.fieldFuncs)]
+	c.ctxFields = l.ctxFields[:len(l.ctxFields):len(l.ctxFields)]
+	c.dataProviders = l.dataProviders[:len(l.dataProviders):len(l.dataProviders)]
+	c.transforms = &transformChain{parent: l.transforms}
+
+	if l.dynamicLevel == nil {
+		c.threshold = newThreshold(l.GetThreshold())
+	}
+
+	return &c
+}
+
+// Child returns a child of the Logger, configured by the given options on top of the configuration of the Logger.
+// The child shares the output of the Logger and keeps its hooks, filters and sinks, so a subsystem can add its own,
+// such as a redaction hook, without re-registering the configuration of the Logger. The Logger is left unchanged.
+//
+// Parameters:
+//   - options: Variadic options to configure the child.
+//
+// Returns:
+//   - A pointer to the child Logger.
+//
+// Example:
+//
+//	payments := logger.Child(loggo.WithPreHook(redactCardNumbers))
+//	payments.Info("Charged card 4111 1111 1111 1111")
+func (l *Logger) Child(options ...Option) *Logger {
+	c := l.clone()
+	c.templates = &templateCache{}
+
+	for _, option := range options {
+		option(c)
+	}
+
+	if c.dynamicLevel == l.dynamicLevel && c.threshold != l.threshold {
+		c.dynamicLevel = nil
+	}
+
+	c.checkConfig()
+	c.resolveColor()
+	c.startAggregations()
+	c.startDynamicLevel()
+	_, _ = c.compiledTemplate()
+
+	return c
+}
+
+// WithTemplateOnce returns a copy of the Logger using a different template, leaving the Logger unchanged.
+// It is meant for single calls that need a special format, such as a banner, sharing everything else with the Logger.
+// The copy renders the template even if the Logger uses another Format or Encoder.
+//
+// Parameters:
+//   - template: The template string for the log message.
+//
+// Returns:
+//   - A pointer to the copy of the Logger.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo)
+//	logger.WithTemplateOnce("===== {{.Message}} =====").Info("Starting server")
+//	logger.Info("Listening on :8080")
+func (l *Logger) WithTemplateOnce(template string) *Logger {
+	c := l.clone()
+	c.template = template
+	c.templates = &templateCache{}
+	c.format = FormatText
+	c.encoder = nil
+
+	return c
+}
+
+// AlsoTo returns a copy of the Logger that also writes to the given writer, leaving the Logger unchanged.
+// It is meant for single calls that must reach an additional destination, such as echoing a summary line to the
+// terminal while the regular logs go to a file.
+//
+// Parameters:
+//   - output: The additional io.Writer to write to.
+//
+// Returns:
+//   - A pointer to the copy of the Logger.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(file))
+//	logger.AlsoTo(os.Stderr).Info("Import finished: 1024 records")
+func (l *Logger) AlsoTo(output io.Writer) *Logger {
+	c := l.clone()
+	c.extraOutputs = append(c.extraOutputs, output)
+	c.checkConfig()
+
+	return c
+}
+
+// If returns the Logger if the condition is true, and a copy of it that discards every message otherwise.
+// It avoids wrapping log calls in if statements purely for logging. Like the calls skipped by an if statement, the
+// Fatal and Panic methods of the discarding copy neither exit nor panic.
+//
+// Parameters:
+//   - cond: The condition for the messages to be logged.
+//
+// Returns:
+//   - The Logger, or a pointer to a discarding copy of it.
+//
+// Example:
+//
+//	logger.If(attempt > 1).Infof("Attempt %d", attempt)
+func (l *Logger) If(cond bool) *Logger {
+	if cond {
+		return l
+	}
+
+	c := l.clone()
+	c.disabled = true
+
+	return c
+}
+
+// IfErr returns the Logger if the error is not nil, and a copy of it that discards every message otherwise.
+//
+// Parameters:
+//   - err: The error for the messages to be logged.
+//
+// Returns:
+//   - The Logger, or a pointer to a discarding copy of it.
+//
+// Example:
+//
+//	err := connect()
+//	logger.IfErr(err).Warn("Retrying connection")
+func (l *Logger) IfErr(err error) *Logger {
+	return l.If(err != nil)
+}
+
+// With returns a copy of the Logger attaching the given fields to every entry, after the fields of the Logger, leaving
+// the Logger unchanged. The arguments are alternating keys and values, e.g. "request_id", id, or Field values, and are
+// rendered by {{.Fields}} and the encoders.
+//
+// Parameters:
+//   - keyvals: The alternating keys and values, or Field values, of the fields. A key without a value is given the
+//     value "!MISSING".
+//
+// Returns:
+//   - A pointer to the copy of the Logger.
+//
+// Example:
+//
+//	logger.With("request_id", id).Info("handled")
+//	// Output: 2024-09-03 15:04:05 [ INFO]: handled request_id=42
+func (l *Logger) With(keyvals ...any) *Logger {
+	l.checkKeyvals(keyvals)
+
+	c := l.clone()
+	c.fields = append(c.fields, fieldsOf(keyvals)...)
+
+	return c
+}
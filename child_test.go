@@ -0,0 +1,159 @@
+package loggo_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+func ExampleLogger_WithTemplateOnce() {
+	logger := loggo.New(loggo.LevelInfo, loggo.WithTimeProvider(fakeNow))
+
+	logger.WithTemplateOnce("===== {{.Message}} =====").Info("Starting server")
+	logger.Info("Listening on :8080")
+	// Output: ===== Starting server =====
+	// 2022-01-25 00:00:00 [ INFO]: Listening on :8080
+}
+
+func TestLogger_AlsoTo(t *testing.T) {
+	w := &strings.Builder{}
+	extra := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Message}}"))
+
+	logger.AlsoTo(extra).Info("Import finished")
+	logger.Info("Regular message")
+
+	if want := "Import finished\nRegular message\n"; w.String() != want {
+		t.Errorf("Logger.Info() output = %q, want %q", w.String(), want)
+	}
+
+	if want := "Import finished\n"; extra.String() != want {
+		t.Errorf("Logger.AlsoTo() output = %q, want %q", extra.String(), want)
+	}
+
+	if err := logger.AlsoTo(errorWriter{}).LogE(loggo.LevelInfo, "Failed"); err == nil {
+		t.Error("Logger.LogE() error = nil, want an error")
+	}
+}
+
+func TestLogger_If(t *testing.T) {
+	w := &strings.Builder{}
+	calls := 0
+	logger := loggo.New(
+		loggo.LevelInfo,
+		loggo.WithOutput(w),
+		loggo.WithTemplate("{{.Message}}"),
+		loggo.WithPreHook(func(*loggo.Logger, *string) { calls++ }),
+	)
+
+	logger.If(false).Info("skipped")
+	logger.If(true).Info("logged")
+	logger.IfErr(nil).Warn("skipped")
+	logger.IfErr(errors.New("failure")).Warn("retrying")
+	_ = loggo.Replay([]loggo.Entry{{Level: loggo.LevelInfo, Message: "skipped"}}, logger.If(false))
+
+	if want := "logged\nretrying\n"; w.String() != want {
+		t.Errorf("Logger.If() output = %q, want %q", w.String(), want)
+	}
+
+	if calls != 2 {
+		t.Errorf("Logger.If() pre-hook calls = %d, want 2", calls)
+	}
+}
+
+func TestLogger_Child(t *testing.T) {
+	w := &strings.Builder{}
+	upper := func(l *loggo.Logger, msg *string) { *msg = strings.ToUpper(*msg) }
+	redact := func(l *loggo.Logger, msg *string) { *msg = strings.ReplaceAll(*msg, "SECRET", "******") }
+	noHealth := func(e loggo.Entry) bool { return !strings.Contains(e.Message, "HEALTH") }
+
+	parent := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Message}}"), loggo.WithPreHook(upper))
+	child := parent.Child(loggo.WithPreHook(redact), loggo.WithFilter(noHealth))
+	sibling := parent.Child()
+
+	child.Info("child secret")
+	child.Info("child health")
+	parent.Info("parent secret")
+	parent.Info("parent health")
+	sibling.Info("sibling secret")
+
+	want := "CHILD ******\nPARENT SECRET\nPARENT HEALTH\nSIBLING SECRET\n"
+	if w.String() != want {
+		t.Errorf("Logger.Child() output = %q, want %q", w.String(), want)
+	}
+}
+
+func TestLogger_filter(t *testing.T) {
+	observer := loggotest.NewObserver()
+	onlyErrors := func(e loggo.Entry) bool { return e.Level >= loggo.LevelError }
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(io.Discard), loggo.WithSink(observer), loggo.WithFilter(onlyErrors))
+
+	logger.Info("discarded")
+	logger.Error("logged")
+
+	b := logger.Batch()
+	b.Warn("discarded")
+	b.Error("batched")
+	_ = b.Flush()
+
+	_ = loggo.Replay([]loggo.Entry{{Level: loggo.LevelInfo, Message: "discarded"}, {Level: loggo.LevelFatal, Message: "replayed"}}, logger)
+
+	if got := fmt.Sprint(observer.All()); got != "[[ERROR] logged [ERROR] batched [FATAL] replayed]" {
+		t.Errorf("Logger filter entries = %s", got)
+	}
+}
+
+func TestLogger_With(t *testing.T) {
+	type testCase struct {
+		name    string
+		keyvals []any
+		want    string
+	}
+
+	testCases := []testCase{
+		{name: "pairs", keyvals: []any{"request_id", 42, "path", "/a b"}, want: `handled request_id=42 path="/a b"`},
+		{name: "field", keyvals: []any{loggo.F("user", "bob"), "ok", true}, want: "handled user=bob ok=true"},
+		{name: "non-string key", keyvals: []any{7, "seven"}, want: "handled 7=seven"},
+		{name: "missing value", keyvals: []any{"orphan"}, want: "handled orphan=!MISSING"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Message}} {{.Fields}}"))
+
+			logger.With(tc.keyvals...).Info("handled")
+
+			if got := strings.TrimSuffix(w.String(), "\n"); got != tc.want {
+				t.Errorf("output = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLogger_With_chained(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Message}} {{.Fields}}"))
+
+	request := logger.With("request_id", 42)
+	first := request.With("step", 1)
+	second := request.With("step", 2)
+
+	release := loggo.PushFields(loggo.F("job", "sync"))
+	first.Info("first")
+	second.Info("second")
+	request.Child().Info("third")
+	logger.Info("fourth")
+	release()
+
+	want := "first job=sync request_id=42 step=1\nsecond job=sync request_id=42 step=2\n" +
+		"third job=sync request_id=42\nfourth job=sync\n"
+	if w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
@@ -0,0 +1,291 @@
+package loggo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Close closes the Logger. It stops its aggregations, logging the summaries of their last window, and logs the
+// summary of the levels logged, if enabled with WithSummary, whatever its Threshold and even if muted, then flushes
+// the sinks implementing a Flush method, such as a Spool or a Journal, and closes the output and the sinks of the
+// Logger implementing io.Closer, except the standard output and error. Closing a Logger closes the output shared with
+// the loggers derived from it. Closing a derived Logger only closes what it added to the Logger it is derived from,
+// such as the output of AlsoTo or the sinks of a Child, and never logs the summary. Close only has effect once. Use
+// CloseContext or CloseWithTimeout so a hung sink cannot block the shutdown forever.
+//
+// Returns:
+//   - An error if the summary could not be logged, or a sink could not be flushed, or an output or sink could not be
+//     closed, nil otherwise.
+//
+// Example:
+//
+//	file, _ := loggo.OpenFile("job.log")
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(file), loggo.WithSummary())
+//	defer logger.Close() // logged 10234 info, 57 warn, 3 error over 2h13m
+func (l *Logger) Close() error {
+	return l.CloseContext(context.Background())
+}
+
+// CloseContext closes the Logger like Close, giving up once the context is done. The work still pending then, such
+// as flushing a sink waiting on an unreachable collector, is abandoned: it goes on in the background, and its summary
+// is written to the error output of the Logger, see WithErrorOutput, e.g. "close abandoned: flushing 1 of 2 sinks".
+//
+// Parameters:
+//   - ctx: The context bounding the shutdown.
+//
+// Returns:
+//   - An error if the shutdown was abandoned, or Close would have returned one, nil otherwise.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//
+//	if err := logger.CloseContext(ctx); err != nil {
+//		fmt.Fprintln(os.Stderr, err)
+//	}
+func (l *Logger) CloseContext(ctx context.Context) error {
+	if l.closed.Swap(true) {
+		return nil
+	}
+
+	var summary string
+	if l.parent == nil && l.summary {
+		l.mu.Lock()
+		summary = l.health.summary()
+		l.mu.Unlock()
+	}
+
+	owned := l.owned()
+	progress := &closeProgress{}
+	done := make(chan error, 1)
+
+	go func() {
+		done <- l.drain(owned, summary, progress)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		err := errors.New("close abandoned: " + progress.abandoned(len(owned.sinks)))
+		l.reportError(err)
+
+		return err
+	}
+}
+
+// CloseWithTimeout closes the Logger like Close, giving up after the timeout, see CloseContext.
+//
+// Parameters:
+//   - timeout: The maximum duration of the shutdown.
+//
+// Returns:
+//   - An error if the shutdown was abandoned, or Close would have returned one, nil otherwise.
+//
+// Example:
+//
+//	defer logger.CloseWithTimeout(5 * time.Second)
+func (l *Logger) CloseWithTimeout(timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return l.CloseContext(ctx)
+}
+
+// flusher is implemented by the sinks buffering entries, such as a Spool or a Journal.
+type flusher interface {
+	Flush() error
+}
+
+// resources are the outputs, sinks, aggregations and dynamic level closed by the Close of a logger.
+type resources struct {
+	outputs      []io.Writer
+	sinks        []*sinkState
+	aggregations []*aggregation
+	dynamicLevel *dynamicLevel
+}
+
+// owned returns the resources of the logger, without the ones of the logger it is derived from, if any, which are
+// closed by the Close of that logger.
+func (l *Logger) owned() resources {
+	p := l.parent
+	if p == nil {
+		return resources{
+			outputs:      append([]io.Writer{l.output, l.splitOutput}, l.extraOutputs...),
+			sinks:        l.sinks,
+			aggregations: l.aggregations,
+			dynamicLevel: l.dynamicLevel,
+		}
+	}
+
+	var r resources
+
+	if l.ownsOutput {
+		r.outputs = append(r.outputs, l.output)
+	}
+
+	if l.ownsSplitOutput {
+		r.outputs = append(r.outputs, l.splitOutput)
+	}
+
+	r.outputs = append(r.outputs, l.extraOutputs[len(p.extraOutputs):]...)
+	r.sinks = l.sinks[len(p.sinks):]
+	r.aggregations = l.aggregations[len(p.aggregations):]
+
+	if l.dynamicLevel != p.dynamicLevel {
+		r.dynamicLevel = l.dynamicLevel
+	}
+
+	return r
+}
+
+// closeProgress tracks the stage reached by the shutdown of a logger, to summarize the abandoned work.
+type closeProgress struct {
+	stage   atomic.Int32 // Stage of the shutdown, one of the close stages
+	flushed atomic.Int32 // Number of sinks flushed
+}
+
+// Stages of the shutdown of a logger.
+const (
+	closeSummarizing int32 = iota
+	closeFlushing
+	closeClosing
+)
+
+// abandoned returns the summary of the work abandoned at the current stage of the shutdown, e.g.
+// "flushing 1 of 2 sinks, closing the outputs and sinks".
+func (p *closeProgress) abandoned(sinks int) string {
+	var work []string
+
+	switch p.stage.Load() {
+	case closeSummarizing:
+		work = append(work, "logging the summaries")
+		fallthrough
+	case closeFlushing:
+		if pending := sinks - int(p.flushed.Load()); pending > 0 {
+			work = append(work, "flushing "+strconv.Itoa(pending)+" of "+strconv.Itoa(sinks)+" sinks")
+		}
+		fallthrough
+	default:
+		work = append(work, "closing the outputs and sinks")
+	}
+
+	return strings.Join(work, ", ")
+}
+
+// drain stops the aggregations and logs the summary, if any, then flushes and closes the sinks and outputs owned by
+// the logger, recording its progress.
+func (l *Logger) drain(owned resources, summary string, progress *closeProgress) error {
+	owned.dynamicLevel.stop()
+
+	errs := []error{l.stopAggregations(owned.aggregations)}
+
+	if summary != "" {
+		errs = append(errs, l.write(newSummaryEntry(LevelInfo, summary, l)))
+	}
+
+	if l.parent == nil {
+		l.mu.Lock()
+		l.health.drained = true
+		l.mu.Unlock()
+	}
+
+	progress.stage.Store(closeFlushing)
+
+	for _, s := range owned.sinks {
+		if f, ok := s.sink.(flusher); ok {
+			errs = append(errs, f.Flush())
+		}
+
+		progress.flushed.Add(1)
+	}
+
+	progress.stage.Store(closeClosing)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, output := range owned.outputs {
+		errs = append(errs, closeWriter(output))
+	}
+
+	for _, s := range owned.sinks {
+		if closer, ok := s.sink.(io.Closer); ok {
+			errs = append(errs, closer.Close())
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// closeWriter closes the writer if it implements io.Closer, except the standard output and error.
+func closeWriter(w io.Writer) error {
+	if w == nil || w == os.Stdout || w == os.Stderr {
+		return nil
+	}
+
+	if closer, ok := w.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
+
+// summary returns the summary of the levels logged since the creation of the logger, e.g.
+// "logged 10234 info, 57 warn, 3 error over 2h13m". The lock must be held.
+func (h *health) summary() string {
+	var b strings.Builder
+
+	b.WriteString("logged ")
+
+	var counts []string
+
+	for _, level := range Levels() {
+		if n := h.entries[level]; n > 0 {
+			counts = append(counts, strconv.FormatUint(n, 10)+" "+strings.ToLower(level.String()))
+		}
+	}
+
+	if len(counts) == 0 {
+		counts = append(counts, "nothing")
+	}
+
+	b.WriteString(strings.Join(counts, ", "))
+	b.WriteString(" over ")
+	b.WriteString(formatDuration(h.clock.Now().Sub(h.start)))
+
+	return b.String()
+}
+
+// formatDuration formats a duration rounded to the second, without its zero units, e.g. "2h13m".
+// Durations under a second are rounded to the millisecond.
+func formatDuration(d time.Duration) string {
+	if d < time.Second {
+		return d.Round(time.Millisecond).String()
+	}
+
+	d = d.Round(time.Second)
+
+	var b strings.Builder
+
+	if h := d / time.Hour; h > 0 {
+		b.WriteString(strconv.FormatInt(int64(h), 10) + "h")
+	}
+
+	if m := d % time.Hour / time.Minute; m > 0 {
+		b.WriteString(strconv.FormatInt(int64(m), 10) + "m")
+	}
+
+	if s := d % time.Minute / time.Second; s > 0 {
+		b.WriteString(strconv.FormatInt(int64(s), 10) + "s")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,226 @@
+package loggo_test
+
+import (
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+// closeRecorder is a writer and sink recording whether it was closed.
+type closeRecorder struct {
+	strings.Builder
+	closed int
+	err    error
+}
+
+func (c *closeRecorder) WriteEntry(loggo.Entry) error { return nil }
+
+func (c *closeRecorder) Close() error {
+	c.closed++
+
+	return c.err
+}
+
+func TestLogger_Close_summary(t *testing.T) {
+	type testCase struct {
+		name    string
+		logs    func(l *loggo.Logger)
+		elapsed time.Duration
+		want    string
+	}
+
+	testCases := []testCase{
+		{
+			name: "levels",
+			logs: func(l *loggo.Logger) {
+				l.Info("first")
+				l.Child().Info("second")
+				l.Warn("third")
+				l.Error("fourth")
+				l.Debug("discarded")
+			},
+			elapsed: 2*time.Hour + 13*time.Minute + 20*time.Second,
+			want:    "logged 2 info, 1 warn, 1 error over 2h13m20s",
+		},
+		{
+			name:    "nothing",
+			logs:    func(*loggo.Logger) {},
+			elapsed: 1500 * time.Microsecond,
+			want:    "logged nothing over 2ms",
+		},
+		{
+			name:    "whole minutes",
+			logs:    func(l *loggo.Logger) { l.Error("failed") },
+			elapsed: 3 * time.Minute,
+			want:    "logged 1 error over 3m",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := &loggotest.Recorder{}
+			logger, clock := loggotest.New(loggo.LevelInfo, loggo.WithOutput(recorder), loggo.WithTemplate("{{.Message}}"), loggo.WithSummary())
+
+			tc.logs(logger)
+			clock.Advance(tc.elapsed)
+
+			if err := logger.Close(); err != nil {
+				t.Errorf("Logger.Close() error = %v", err)
+			}
+
+			lines := recorder.All()
+			if got := lines[len(lines)-1]; got != tc.want {
+				t.Errorf("summary = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLogger_Close(t *testing.T) {
+	output := &closeRecorder{}
+	extra := &closeRecorder{}
+	sink := &closeRecorder{err: errors.New("sink close failure")}
+	childSink := &closeRecorder{}
+
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(output), loggo.WithSink(sink))
+	also := logger.AlsoTo(extra)
+	child := also.Child(loggo.WithSink(childSink))
+
+	if err := child.Close(); err != nil {
+		t.Errorf("child Logger.Close() error = %v", err)
+	}
+
+	if err := also.Close(); err != nil {
+		t.Errorf("derived Logger.Close() error = %v", err)
+	}
+
+	if output.closed != 0 || extra.closed != 1 || sink.closed != 0 || childSink.closed != 1 {
+		t.Errorf("closed output, extra output, sink, child sink = %d, %d, %d, %d times, want the derived ones only",
+			output.closed, extra.closed, sink.closed, childSink.closed)
+	}
+
+	if err := logger.Close(); err == nil || err.Error() != "sink close failure" {
+		t.Errorf("Logger.Close() error = %v, want sink close failure", err)
+	}
+
+	if err := logger.Close(); err != nil {
+		t.Errorf("second Logger.Close() error = %v", err)
+	}
+
+	if output.closed != 1 || extra.closed != 1 || sink.closed != 1 || childSink.closed != 1 {
+		t.Errorf("closed output, extra output, sink, child sink = %d, %d, %d, %d times, want 1 each",
+			output.closed, extra.closed, sink.closed, childSink.closed)
+	}
+
+	if output.String() != "" {
+		t.Errorf("output = %q, want no summary", output.String())
+	}
+}
+
+func TestLogger_Close_summaryDiscarded(t *testing.T) {
+	type testCase struct {
+		name      string
+		threshold loggo.Level
+		muted     bool
+	}
+
+	testCases := []testCase{
+		{name: "threshold", threshold: loggo.LevelError},
+		{name: "muted", threshold: loggo.LevelInfo, muted: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			logger := loggo.New(tc.threshold, loggo.WithOutput(w), loggo.WithTimeProvider(fakeNow),
+				loggo.WithTemplate("{{.Level}} {{.Message}} ({{.Caller}})"), loggo.WithSummary())
+			if tc.muted {
+				logger.Mute()
+			}
+
+			logger.Info("discarded")
+			_ = logger.With("component", "db").Close()
+
+			if err := logger.Close(); err != nil {
+				t.Errorf("Logger.Close() error = %v", err)
+			}
+
+			if want := "INFO logged nothing over 0s (unknown)\n"; w.String() != want {
+				t.Errorf("output = %q, want %q", w.String(), want)
+			}
+		})
+	}
+}
+
+// flushSink is a Sink whose Flush blocks until it is released.
+type flushSink struct {
+	release chan struct{}
+	flushed atomic.Bool
+}
+
+func (s *flushSink) WriteEntry(loggo.Entry) error { return nil }
+
+func (s *flushSink) Flush() error {
+	<-s.release
+	s.flushed.Store(true)
+
+	return nil
+}
+
+func TestLogger_CloseWithTimeout(t *testing.T) {
+	type testCase struct {
+		name      string
+		hang      bool
+		wantErr   string
+		wantPrint string
+	}
+
+	testCases := []testCase{
+		{name: "flushed"},
+		{
+			name:      "abandoned",
+			hang:      true,
+			wantErr:   "close abandoned: flushing 1 of 2 sinks, closing the outputs and sinks",
+			wantPrint: "loggo: close abandoned: flushing 1 of 2 sinks, closing the outputs and sinks\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sink := &flushSink{release: make(chan struct{})}
+			if !tc.hang {
+				close(sink.release)
+			}
+
+			errOutput := &strings.Builder{}
+			logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(&loggotest.Recorder{}), loggo.WithSink(&closeRecorder{}),
+				loggo.WithSink(sink), loggo.WithErrorOutput(errOutput))
+
+			err := logger.CloseWithTimeout(50 * time.Millisecond)
+			if tc.hang {
+				close(sink.release)
+			}
+
+			if tc.wantErr == "" {
+				if err != nil || !sink.flushed.Load() {
+					t.Errorf("Logger.CloseWithTimeout() error = %v, flushed = %v, want nil, true", err, sink.flushed.Load())
+				}
+
+				return
+			}
+
+			if err == nil || err.Error() != tc.wantErr {
+				t.Errorf("Logger.CloseWithTimeout() error = %v, want %q", err, tc.wantErr)
+			}
+
+			if errOutput.String() != tc.wantPrint {
+				t.Errorf("error output = %q, want %q", errOutput.String(), tc.wantPrint)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/hvpaiva/loggo"
+)
+
+// colorModes are the color modes of the -color flag.
+var colorModes = map[string]loggo.ColorMode{
+	"auto":   loggo.ColorAuto,
+	"always": loggo.ColorAlways,
+	"never":  loggo.ColorNever,
+}
+
+// config is the configuration of the pretty printer.
+type config struct {
+	level  loggo.Level    // Minimum level of the entries to print
+	fields []string       // Fields to print, all fields if empty
+	color  bool           // Whether to color the output
+	grep   *regexp.Regexp // Pattern the printed lines must match, nil to print all lines
+}
+
+// flags are the command line flags shared by all the commands.
+type flags struct {
+	level  *loggo.Level
+	fields *string
+	color  *string
+	grep   *string
+}
+
+// addFlags defines the shared flags in the flag set.
+func addFlags(fs *flag.FlagSet) flags {
+	level := loggo.LevelTrace
+	fs.Var(&level, "level", "minimum level of the entries to print")
+
+	return flags{
+		level:  &level,
+		fields: fs.String("fields", "", "comma-separated list of the fields to print"),
+		color:  fs.String("color", "auto", `when to color the output: "auto", "always" or "never"`),
+		grep:   fs.String("grep", "", "regular expression the printed lines must match"),
+	}
+}
+
+// config returns the config described by the parsed flags.
+func (f flags) config(stdout io.Writer) (config, error) {
+	cfg := config{level: *f.level}
+
+	if *f.fields != "" {
+		cfg.fields = strings.Split(*f.fields, ",")
+	}
+
+	mode, ok := colorModes[*f.color]
+	if !ok {
+		return config{}, errors.New("unknown color mode: " + *f.color)
+	}
+
+	cfg.color = mode.Enabled(stdout)
+
+	if *f.grep != "" {
+		re, err := regexp.Compile(*f.grep)
+		if err != nil {
+			return config{}, errors.New("invalid grep pattern: " + err.Error())
+		}
+
+		cfg.grep = re
+	}
+
+	return cfg, nil
+}
+
+// parseFlags parses the flags of the pretty printer into a config.
+func parseFlags(name string, args []string, stdout, stderr io.Writer) (config, error) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	f := addFlags(fs)
+
+	if err := fs.Parse(args); err != nil {
+		return config{}, err
+	}
+
+	return f.config(stdout)
+}
+
+// parseInterspersed parses the flags in args, allowing them to appear after positional arguments.
+// It returns the positional arguments.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
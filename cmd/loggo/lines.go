@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// maxLineSize is the maximum size, in bytes, of the lines read; the longer ones are skipped.
+const maxLineSize = 1024 * 1024
+
+// lineReader reads the lines of a reader without their line endings, "\n" or "\r\n". The lines longer than
+// maxLineSize are skipped, and reported to stderr, so one huge line does not stop the command. The end of the reader
+// without a line ending is kept as a partial line, completed by the next reads, so a file can be read as it grows.
+type lineReader struct {
+	reader  *bufio.Reader
+	stderr  io.Writer
+	line    []byte // Partial line read so far
+	skipped bool   // Whether the partial line is too long, and skipped
+	number  int    // Number of the partial line, from 1
+	read    int64  // Number of bytes read, including the partial line
+}
+
+// newLineReader returns a lineReader reading the lines of r, and reporting the skipped ones to stderr.
+func newLineReader(r io.Reader, stderr io.Writer) *lineReader {
+	return &lineReader{reader: bufio.NewReaderSize(r, 64*1024), stderr: stderr, number: 1}
+}
+
+// next returns the next complete line, and false once the reader has no complete line left.
+func (r *lineReader) next() (string, bool, error) {
+	for {
+		chunk, err := r.reader.ReadSlice('\n')
+		r.read += int64(len(chunk))
+
+		switch {
+		case errors.Is(err, bufio.ErrBufferFull):
+			r.append(chunk)
+
+			continue
+		case errors.Is(err, io.EOF):
+			r.append(chunk)
+
+			return "", false, nil
+		case err != nil:
+			return "", false, err
+		}
+
+		r.append(chunk[:len(chunk)-1])
+
+		line, ok := r.take()
+		if ok {
+			return line, true, nil
+		}
+	}
+}
+
+// rest returns the partial line left at the end of the reader, and whether there is one.
+func (r *lineReader) rest() (string, bool) {
+	if len(r.line) == 0 && !r.skipped {
+		return "", false
+	}
+
+	return r.take()
+}
+
+// reset discards the partial line and reads the lines of src from its start.
+func (r *lineReader) reset(src io.Reader) {
+	r.reader.Reset(src)
+	r.line, r.skipped, r.number, r.read = r.line[:0], false, 1, 0
+}
+
+// append adds a chunk to the partial line, unless the line is too long, allowing the "\r" of its line ending.
+func (r *lineReader) append(chunk []byte) {
+	if r.skipped {
+		return
+	}
+
+	if len(r.line)+len(chunk) > maxLineSize+1 {
+		r.line, r.skipped = r.line[:0], true
+
+		return
+	}
+
+	r.line = append(r.line, chunk...)
+}
+
+// take returns the partial line as a complete one, and false if it is skipped, reporting it.
+func (r *lineReader) take() (string, bool) {
+	line := strings.TrimSuffix(string(r.line), "\r")
+	skipped := r.skipped || len(line) > maxLineSize
+
+	if skipped {
+		fmt.Fprintf(r.stderr, "loggo: line %d skipped: longer than %d bytes\n", r.number, maxLineSize)
+	}
+
+	r.line, r.skipped = r.line[:0], false
+	r.number++
+
+	return line, !skipped
+}
+
+// readLines calls fn with each line read from r, including the last one without a line ending, until the end of r or
+// an error of fn, see lineReader.
+func readLines(r io.Reader, stderr io.Writer, fn func(line string) error) error {
+	lines := newLineReader(r, stderr)
+
+	for {
+		line, ok, err := lines.next()
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			break
+		}
+
+		if err = fn(line); err != nil {
+			return err
+		}
+	}
+
+	if line, ok := lines.rest(); ok {
+		return fn(line)
+	}
+
+	return nil
+}
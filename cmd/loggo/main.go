@@ -0,0 +1,69 @@
+// Command loggo pretty-prints loggo JSON and logfmt output.
+//
+// It reads log lines from the standard input and writes them, colored and aligned, to the standard output. Lines that
+// are neither JSON nor logfmt are written unchanged, and filtered by -level when they are in the default text format of
+// loggo, e.g. "2024-09-03 15:04:05 [ WARN]: disk full". Lines longer than 1 MiB are skipped and reported.
+//
+// Usage:
+//
+//	loggo [flags] < app.log
+//	loggo tail [-f] [-n lines] [flags] app.log
+//
+// The tail command prints the last lines of a file and, with -f, keeps printing the lines appended to it. Rotated
+// files (renamed and recreated, or truncated) are followed automatically.
+//
+// The flags are:
+//
+//	-level string
+//		Minimum level of the entries to print (e.g. "warn").
+//	-fields string
+//		Comma-separated list of the fields to print. All fields are printed by default.
+//	-color string
+//		When to color the output: "auto", "always" or "never" (default "auto"). In auto mode, the output is colored
+//		when it is a terminal, unless NO_COLOR is set, or when CLICOLOR_FORCE is set to anything other than "0".
+//	-grep string
+//		Regular expression the printed lines must match.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	err := run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+
+	stop()
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "loggo:", err)
+		os.Exit(2)
+	}
+}
+
+// run executes the command with the given arguments, reading from stdin and writing to stdout, and writing the usage
+// and the skipped lines to stderr. Asking for the usage with -h is not an error.
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	var err error
+
+	if len(args) > 0 && args[0] == "tail" {
+		err = runTail(ctx, args[1:], stdout, stderr)
+	} else {
+		var cfg config
+		if cfg, err = parseFlags("loggo", args, stdout, stderr); err == nil {
+			err = prettyPrint(stdin, stdout, stderr, cfg)
+		}
+	}
+
+	if errors.Is(err, flag.ErrHelp) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	type testCase struct {
+		name  string
+		args  []string
+		input string
+		want  string
+	}
+
+	testCases := []testCase{
+		{
+			name:  "json",
+			input: `{"time":"2022-01-25 00:00:00","level":"INFO","message":"started","caller":"main.go:10","port":8080}` + "\n",
+			want:  "2022-01-25 00:00:00 [ INFO] started port=8080 (main.go:10)\n",
+		},
+		{
+			name:  "logfmt",
+			input: `time="2022-01-25 00:00:00" level=warn msg="disk \"almost\" full" usage=91%` + "\n",
+			want:  "2022-01-25 00:00:00 [ WARN] disk \"almost\" full usage=91%\n",
+		},
+		{
+			name:  "plain text",
+			input: "panic: something happened\n",
+			want:  "panic: something happened\n",
+		},
+		{
+			name:  "level filter",
+			args:  []string{"-level", "warn"},
+			input: "level=info msg=first\nlevel=error msg=second\nlevel=custom msg=third\n",
+			want:  "[ERROR] second\n[CUSTOM] third\n",
+		},
+		{
+			name:  "text level filter",
+			args:  []string{"-level", "warn"},
+			input: "2022-01-25 00:00:00 [ INFO]: started\r\n2022-01-25 00:00:00 [ WARN]: disk full\r\npanic: boom\r\n",
+			want:  "2022-01-25 00:00:00 [ WARN]: disk full\npanic: boom\n",
+		},
+		{
+			name:  "field selection",
+			args:  []string{"-fields", "user"},
+			input: `{"level":"INFO","message":"login","user":"alice","ip":"10.0.0.1","roles":["admin"]}` + "\n",
+			want:  "[ INFO] login user=alice\n",
+		},
+		{
+			name:  "grep",
+			args:  []string{"-grep", "pay(ment)?"},
+			input: "level=info msg=login\nlevel=info msg=\"payment done\"\n",
+			want:  "[ INFO] payment done\n",
+		},
+		{
+			name:  "color",
+			args:  []string{"-color", "always"},
+			input: "level=error msg=failed\n",
+			want:  "\x1b[31m[ERROR]\x1b[0m \x1b[1mfailed\x1b[0m\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			if err := run(context.Background(), tc.args, strings.NewReader(tc.input), w, &strings.Builder{}); err != nil {
+				t.Fatalf("run() error = %v", err)
+			}
+
+			if w.String() != tc.want {
+				t.Errorf("run() = %q, want %q", w.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestRun_invalidFlags(t *testing.T) {
+	testCases := map[string][]string{
+		"unknown flag":  {"-unknown"},
+		"unknown level": {"-level", "verbose"},
+		"unknown color": {"-color", "sometimes"},
+		"invalid grep":  {"-grep", "("},
+	}
+
+	for name, args := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if err := run(context.Background(), args, strings.NewReader(""), &strings.Builder{}, &strings.Builder{}); err == nil {
+				t.Error("run() error = nil, want an error")
+			}
+		})
+	}
+}
+
+func TestRun_help(t *testing.T) {
+	for _, args := range [][]string{{"-h"}, {"tail", "-h"}} {
+		t.Run(strings.Join(args, " "), func(t *testing.T) {
+			stdout, stderr := &strings.Builder{}, &strings.Builder{}
+			if err := run(context.Background(), args, strings.NewReader(""), stdout, stderr); err != nil {
+				t.Errorf("run() error = %v, want nil", err)
+			}
+
+			if stdout.Len() != 0 || !strings.Contains(stderr.String(), "Usage") {
+				t.Errorf("run() stdout = %q, stderr = %q, want the usage on stderr", stdout.String(), stderr.String())
+			}
+		})
+	}
+}
+
+func TestRun_longLine(t *testing.T) {
+	input := "level=info msg=first\n" + strings.Repeat("x", maxLineSize+1) + "\nlevel=info msg=third\n"
+
+	stdout, stderr := &strings.Builder{}, &strings.Builder{}
+	if err := run(context.Background(), nil, strings.NewReader(input), stdout, stderr); err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+
+	if want := "[ INFO] first\n[ INFO] third\n"; stdout.String() != want {
+		t.Errorf("run() = %q, want %q", stdout.String(), want)
+	}
+
+	if want := "loggo: line 2 skipped: longer than 1048576 bytes\n"; stderr.String() != want {
+		t.Errorf("stderr = %q, want %q", stderr.String(), want)
+	}
+}
+
+func TestParseLine(t *testing.T) {
+	testCases := []string{
+		`{"level":`,
+		`[1, 2]`,
+		`level="unterminated`,
+		`level="bad \q escape"`,
+		`just some words`,
+		`key=value`,
+	}
+
+	for _, line := range testCases {
+		if rec, ok := parseLine(line); ok {
+			t.Errorf("parseLine(%q) = %+v, want not ok", line, rec)
+		}
+	}
+}
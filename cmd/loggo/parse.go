@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hvpaiva/loggo"
+)
+
+// textLevel matches the level of a line of the default text format of loggo, e.g. "2024-09-03 15:04:05 [ WARN]: ...",
+// possibly colored.
+var textLevel = regexp.MustCompile(`^[^\[]*\[(?:\x1b\[[0-9;]*m)?\s*([^\]\s\x1b]+)(?:\x1b\[[0-9;]*m)?\]: `)
+
+// record is a parsed log line.
+type record struct {
+	time    string
+	level   string
+	message string
+	caller  string
+	fields  []field
+}
+
+// field is a key/value pair of a record, other than its time, level, message and caller.
+type field struct {
+	key   string
+	value string
+}
+
+// set sets a key of the record, either one of the well-known keys or an additional field.
+func (r *record) set(key, value string) {
+	switch key {
+	case "time", "ts":
+		r.time = value
+	case "level", "lvl":
+		r.level = value
+	case "message", "msg":
+		r.message = value
+	case "caller":
+		r.caller = value
+	default:
+		r.fields = append(r.fields, field{key: key, value: value})
+	}
+}
+
+// parseLine parses a JSON or logfmt log line. It returns false if the line is in neither format.
+func parseLine(line string) (record, bool) {
+	trimmed := strings.TrimSpace(line)
+	if strings.HasPrefix(trimmed, "{") {
+		rec, err := parseJSON(trimmed)
+
+		return rec, err == nil
+	}
+
+	rec, err := parseLogfmt(trimmed)
+	if err != nil || (rec.level == "" && rec.message == "") {
+		return record{}, false
+	}
+
+	return rec, true
+}
+
+// parseJSON parses a JSON object log line, keeping the order of its keys.
+func parseJSON(line string) (record, error) {
+	dec := json.NewDecoder(strings.NewReader(line))
+	dec.UseNumber()
+
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return record{}, errors.New("not a JSON object")
+	}
+
+	var rec record
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return record{}, err
+		}
+
+		key, _ := tok.(string)
+
+		var raw json.RawMessage
+		if err = dec.Decode(&raw); err != nil {
+			return record{}, err
+		}
+
+		rec.set(key, jsonValue(raw))
+	}
+
+	return rec, nil
+}
+
+// jsonValue renders a JSON value, unquoting strings and compacting everything else.
+func jsonValue(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return string(raw)
+	}
+
+	return buf.String()
+}
+
+// parseLogfmt parses a logfmt log line.
+func parseLogfmt(line string) (record, error) {
+	var rec record
+
+	for line != "" {
+		eq := strings.IndexByte(line, '=')
+		if eq <= 0 || strings.ContainsAny(line[:eq], " \t\"") {
+			return record{}, errors.New("invalid logfmt key")
+		}
+
+		key := line[:eq]
+		line = line[eq+1:]
+
+		var value string
+
+		if strings.HasPrefix(line, `"`) {
+			end := closingQuote(line)
+			if end < 0 {
+				return record{}, errors.New("unterminated logfmt value")
+			}
+
+			unquoted, err := strconv.Unquote(line[:end+1])
+			if err != nil {
+				return record{}, err
+			}
+
+			value, line = unquoted, line[end+1:]
+		} else {
+			end := strings.IndexAny(line, " \t")
+			if end < 0 {
+				end = len(line)
+			}
+
+			value, line = line[:end], line[end:]
+		}
+
+		rec.set(key, value)
+		line = strings.TrimLeft(line, " \t")
+	}
+
+	return rec, nil
+}
+
+// closingQuote returns the index of the quote closing the quoted string at the start of s, or -1.
+func closingQuote(s string) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+
+	return -1
+}
+
+// parseTextLevel returns the level of a line of the default text format of loggo, and whether it has a known one.
+func parseTextLevel(line string) (loggo.Level, bool) {
+	match := textLevel.FindStringSubmatch(line)
+	if match == nil {
+		return 0, false
+	}
+
+	level, err := loggo.ParseLevel(match[1])
+
+	return level, err == nil
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/hvpaiva/loggo"
+)
+
+// ANSI escape codes used to color the output.
+const (
+	colorReset = "\x1b[0m"
+	colorGray  = "\x1b[90m"
+	colorBold  = "\x1b[1m"
+)
+
+// prettyPrint reads the log lines from r and writes them pretty-printed to w, skipping the lines too long to be read,
+// see lineReader.
+func prettyPrint(r io.Reader, w, stderr io.Writer, cfg config) error {
+	return readLines(r, stderr, func(line string) error {
+		return printLine(w, line, cfg)
+	})
+}
+
+// printLine writes a single log line pretty-printed to w, if it passes the grep and level filters.
+func printLine(w io.Writer, line string, cfg config) error {
+	if cfg.grep != nil && !cfg.grep.MatchString(line) {
+		return nil
+	}
+
+	rec, ok := parseLine(line)
+	if !ok {
+		if level, known := parseTextLevel(line); known && level.Rank() < cfg.level.Rank() {
+			return nil
+		}
+
+		_, err := fmt.Fprintln(w, line)
+
+		return err
+	}
+
+	level, err := loggo.ParseLevel(rec.level)
+	known := err == nil
+
+	if known && level.Rank() < cfg.level.Rank() {
+		return nil
+	}
+
+	_, err = fmt.Fprintln(w, format(rec, level, known, cfg))
+
+	return err
+}
+
+// format renders a record as a single colored line.
+func format(rec record, level loggo.Level, known bool, cfg config) string {
+	var b strings.Builder
+
+	color := cfg.color
+
+	if rec.time != "" {
+		b.WriteString(paint(rec.time, colorGray, color))
+		b.WriteByte(' ')
+	}
+
+	name := strings.ToUpper(rec.level)
+	if known {
+		name = level.String()
+	}
+
+	b.WriteString(paint(fmt.Sprintf("[%5s]", name), level.Color(), color && known))
+	b.WriteByte(' ')
+	b.WriteString(paint(rec.message, colorBold, color))
+
+	for _, f := range rec.fields {
+		if len(cfg.fields) > 0 && !slices.Contains(cfg.fields, f.key) {
+			continue
+		}
+
+		b.WriteByte(' ')
+		b.WriteString(paint(f.key+"=", colorGray, color))
+		b.WriteString(f.value)
+	}
+
+	if rec.caller != "" {
+		b.WriteByte(' ')
+		b.WriteString(paint("("+rec.caller+")", colorGray, color))
+	}
+
+	return b.String()
+}
+
+// paint wraps s in the given ANSI color, if enabled.
+func paint(s, color string, enabled bool) string {
+	if !enabled || color == "" {
+		return s
+	}
+
+	return color + s + colorReset
+}
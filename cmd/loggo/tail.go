@@ -0,0 +1,203 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"time"
+)
+
+// pollInterval is how often a followed file is checked for new lines and rotation.
+var pollInterval = 250 * time.Millisecond
+
+// runTail executes the tail command: it prints the last lines of a file and, with -f, keeps printing the lines
+// appended to it, following rotations.
+func runTail(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("loggo tail", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	f := addFlags(fs)
+	follow := fs.Bool("f", false, "keep printing the lines appended to the file, following rotations")
+	lines := fs.Int("n", 10, "number of lines to print from the end of the file")
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) != 1 {
+		return errors.New("tail: exactly one file is required")
+	}
+
+	cfg, err := f.config(stdout)
+	if err != nil {
+		return err
+	}
+
+	return tailFile(ctx, positional[0], *lines, *follow, stdout, stderr, cfg)
+}
+
+// tailFile prints the last n lines of the file, then follows it if requested. The lines too long to be read are
+// reported to stderr.
+func tailFile(ctx context.Context, path string, n int, follow bool, w, stderr io.Writer, cfg config) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = file.Close() }()
+
+	start, err := lastLines(file, n)
+	if err != nil {
+		return err
+	}
+
+	if _, err = file.Seek(start, io.SeekStart); err != nil {
+		return err
+	}
+
+	t := &tailer{path: path, file: file, lines: newLineReader(file, stderr), start: start, w: w, cfg: cfg}
+	if err = t.drain(); err != nil {
+		return err
+	}
+
+	if !follow {
+		if line, ok := t.lines.rest(); ok {
+			return printLine(w, line, cfg)
+		}
+
+		return nil
+	}
+
+	return t.follow(ctx)
+}
+
+// lastLines returns the offset of the last n lines of the file, reading it backwards from its end, the end of the
+// file without a line ending counting as a line.
+func lastLines(file *os.File, n int) (int64, error) {
+	end, err := file.Seek(0, io.SeekEnd)
+	if err != nil || n <= 0 {
+		return end, err
+	}
+
+	buf := make([]byte, 64*1024)
+	found := 0
+
+	for offset := end; offset > 0; {
+		size := min(offset, int64(len(buf)))
+		offset -= size
+
+		if _, err = file.ReadAt(buf[:size], offset); err != nil {
+			return 0, err
+		}
+
+		for i := size - 1; i >= 0; i-- {
+			// The line ending of the last line does not start another line.
+			if buf[i] != '\n' || offset+i == end-1 {
+				continue
+			}
+
+			if found++; found == n {
+				return offset + i + 1, nil
+			}
+		}
+	}
+
+	return 0, nil
+}
+
+// tailer follows a file, reopening it when it is rotated and rereading it when it is truncated.
+type tailer struct {
+	path  string
+	file  *os.File
+	lines *lineReader
+	start int64 // Offset of the file the lines are read from
+	w     io.Writer
+	cfg   config
+}
+
+// follow prints the lines appended to the file until the context is done.
+func (t *tailer) follow(ctx context.Context) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := t.drain(); err != nil {
+			return err
+		}
+
+		if err := t.checkRotation(); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// drain prints the complete lines available in the file.
+func (t *tailer) drain() error {
+	for {
+		line, ok, err := t.lines.next()
+		if err != nil || !ok {
+			return err
+		}
+
+		if err = printLine(t.w, line, t.cfg); err != nil {
+			return err
+		}
+	}
+}
+
+// checkRotation reopens the file if it was replaced and rereads it if it was truncated.
+func (t *tailer) checkRotation() error {
+	current, err := t.file.Stat()
+	if err != nil {
+		return err
+	}
+
+	// The file may be briefly missing while it is rotated, so it is checked again in the next poll.
+	latest, statErr := os.Stat(t.path)
+	if statErr != nil {
+		return nil
+	}
+
+	if !os.SameFile(current, latest) {
+		if err = t.drain(); err != nil {
+			return err
+		}
+
+		file, openErr := os.Open(t.path)
+		if openErr != nil {
+			return nil
+		}
+
+		_ = t.file.Close()
+		t.file = file
+
+		return t.restart()
+	}
+
+	if latest.Size() < t.start+t.lines.read {
+		if _, err = t.file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+
+		return t.restart()
+	}
+
+	return nil
+}
+
+// restart resets the reading state to the start of the file.
+func (t *tailer) restart() error {
+	t.start = 0
+	t.lines.reset(t.file)
+
+	return nil
+}
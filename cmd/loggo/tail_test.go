@@ -0,0 +1,203 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// syncBuilder is a strings.Builder safe for concurrent use.
+type syncBuilder struct {
+	mu sync.Mutex
+	b  strings.Builder
+}
+
+func (s *syncBuilder) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.b.Write(p)
+}
+
+func (s *syncBuilder) String() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.b.String()
+}
+
+func TestRunTail(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	writeFile(t, path, "level=info msg=first\nlevel=warn msg=second\nlevel=error msg=third\n")
+
+	w := &strings.Builder{}
+	if err := run(context.Background(), []string{"tail", "-n", "2", path, "--level", "warn"}, nil, w, &strings.Builder{}); err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+
+	if want := "[ WARN] second\n[ERROR] third\n"; w.String() != want {
+		t.Errorf("run() = %q, want %q", w.String(), want)
+	}
+}
+
+func TestRunTail_lastLines(t *testing.T) {
+	type testCase struct {
+		name    string
+		content string
+		n       string
+		want    string
+	}
+
+	many := strings.Repeat("level=debug msg=filler\n", 10000)
+
+	testCases := []testCase{
+		{
+			name:    "text",
+			content: "2022-01-25 00:00:00 [ INFO]: started\r\n2022-01-25 00:00:00 [ WARN]: disk full\r\n",
+			n:       "10",
+			want:    "2022-01-25 00:00:00 [ WARN]: disk full\n",
+		},
+		{
+			name:    "large file",
+			content: many + "level=warn msg=first\nlevel=warn msg=second\n",
+			n:       "2",
+			want:    "[ WARN] first\n[ WARN] second\n",
+		},
+		{
+			name:    "no line ending",
+			content: many + "level=warn msg=first\nlevel=warn msg=second",
+			n:       "2",
+			want:    "[ WARN] first\n[ WARN] second\n",
+		},
+		{
+			name:    "long line",
+			content: "level=warn msg=first\n" + strings.Repeat("x", maxLineSize+1) + "\nlevel=warn msg=third\n",
+			n:       "3",
+			want:    "[ WARN] first\n[ WARN] third\n",
+		},
+		{
+			name:    "none",
+			content: "level=warn msg=first\n",
+			n:       "0",
+			want:    "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "app.log")
+			writeFile(t, path, tc.content)
+
+			w := &strings.Builder{}
+			args := []string{"tail", "-n", tc.n, "-level", "warn", path}
+
+			if err := run(context.Background(), args, nil, w, &strings.Builder{}); err != nil {
+				t.Fatalf("run() error = %v", err)
+			}
+
+			if w.String() != tc.want {
+				t.Errorf("run() = %q, want %q", w.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestRunTail_invalidArgs(t *testing.T) {
+	testCases := map[string][]string{
+		"no file":       {"tail"},
+		"two files":     {"tail", "a.log", "b.log"},
+		"unknown flag":  {"tail", "-unknown", "a.log"},
+		"unknown level": {"tail", "-level", "verbose", "a.log"},
+		"missing file":  {"tail", filepath.Join(t.TempDir(), "missing.log")},
+	}
+
+	for name, args := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if err := run(context.Background(), args, nil, &strings.Builder{}, &strings.Builder{}); err == nil {
+				t.Error("run() error = nil, want an error")
+			}
+		})
+	}
+}
+
+func TestRunTail_follow(t *testing.T) {
+	pollInterval = time.Millisecond
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+	writeFile(t, path, "level=info msg=\"payment 0\"\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &syncBuilder{}
+	done := make(chan error)
+
+	go func() {
+		done <- run(ctx, []string{"tail", "-f", "-n", "1", "--grep", "payment", path}, nil, w, &strings.Builder{})
+	}()
+
+	waitFor(t, w, "[ INFO] payment 0\n")
+	appendFile(t, path, "level=info msg=\"payment 1\"\nlevel=info msg=login\nlevel=info msg=\"payment")
+	waitFor(t, w, "[ INFO] payment 0\n[ INFO] payment 1\n")
+
+	appendFile(t, path, " 2\"\n")
+	waitFor(t, w, "[ INFO] payment 0\n[ INFO] payment 1\n[ INFO] payment 2\n")
+
+	if err := os.Rename(path, filepath.Join(dir, "app.log.1")); err != nil {
+		t.Fatal(err)
+	}
+
+	writeFile(t, path, "level=warn msg=\"payment 3\"\n")
+	waitFor(t, w, "[ INFO] payment 0\n[ INFO] payment 1\n[ INFO] payment 2\n[ WARN] payment 3\n")
+
+	// Truncated and rewritten with a shorter content.
+	writeFile(t, path, "level=error msg=payment4\n")
+	waitFor(t, w, "[ INFO] payment 0\n[ INFO] payment 1\n[ INFO] payment 2\n[ WARN] payment 3\n[ERROR] payment4\n")
+
+	cancel()
+
+	if err := <-done; err != nil {
+		t.Errorf("run() error = %v", err)
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func appendFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	if _, err = f.WriteString(content); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func waitFor(t *testing.T, w *syncBuilder, want string) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if w.String() == want {
+			return
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Fatalf("output = %q, want %q", w.String(), want)
+}
@@ -0,0 +1,101 @@
+package loggo
+
+import (
+	"io"
+	"os"
+)
+
+// ColorMode represents when the log lines of a Logger are colored.
+type ColorMode byte
+
+// Available color modes.
+const (
+	// ColorAuto colors the log lines only when the output is a terminal.
+	ColorAuto ColorMode = iota
+	// ColorAlways always colors the log lines.
+	ColorAlways
+	// ColorNever never colors the log lines.
+	ColorNever
+)
+
+// colorReset is the ANSI escape code resetting the color.
+const colorReset = "\x1b[0m"
+
+// levelColors are the ANSI colors of each level.
+var levelColors = map[Level]string{
+	LevelTrace: "\x1b[2;90m",
+	LevelDebug: "\x1b[90m",
+	LevelInfo:  "\x1b[36m",
+	LevelWarn:  "\x1b[33m",
+	LevelError: "\x1b[31m",
+	LevelPanic: "\x1b[91m",
+	LevelFatal: "\x1b[35m",
+}
+
+// Color returns the ANSI escape code coloring the level in the terminal, the one of the highest built-in level ranking
+// below it for a custom level, see RegisterLevel.
+//
+// Returns:
+//   - The ANSI escape code of the color, e.g. "\x1b[33m" for LevelWarn.
+//
+// Example:
+//
+//	fmt.Println(level.Color() + level.String() + "\x1b[0m")
+func (l Level) Color() string {
+	return levelColors[l.base()]
+}
+
+// Enabled reports whether log lines written to w are colored in the color mode.
+//
+// With ColorAuto, the lines are colored when w is a terminal, following the NO_COLOR and CLICOLOR_FORCE conventions:
+// a non-empty NO_COLOR environment variable disables the colors, and a CLICOLOR_FORCE variable set to anything other
+// than "0" enables them even when w is not a terminal. ColorAlways and ColorNever ignore the environment.
+//
+// Parameters:
+//   - w: The output of the log lines.
+//
+// Returns:
+//   - true if the log lines are colored, false otherwise.
+//
+// Example:
+//
+//	colored := loggo.ColorAuto.Enabled(os.Stdout)
+func (m ColorMode) Enabled(w io.Writer) bool {
+	switch m {
+	case ColorAlways:
+		return true
+	case ColorNever:
+		return false
+	}
+
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+
+	if force := os.Getenv("CLICOLOR_FORCE"); force != "" && force != "0" {
+		return true
+	}
+
+	return isTerminal(w)
+}
+
+// resolveColor decides whether the log lines of the logger are colored, from its color mode and output.
+func (l *Logger) resolveColor() {
+	l.colored = l.colorMode.Enabled(l.output)
+}
+
+// isTerminal reports whether the writer is a terminal able to render ANSI colors, enabling their processing on
+// Windows consoles.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	info, err := f.Stat()
+	if err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return false
+	}
+
+	return enableVirtualTerminal(f)
+}
@@ -0,0 +1,12 @@
+//go:build !windows
+
+package loggo
+
+import (
+	"os"
+)
+
+// enableVirtualTerminal reports whether the terminal renders ANSI colors, which they all do outside of Windows.
+func enableVirtualTerminal(*os.File) bool {
+	return true
+}
@@ -0,0 +1,126 @@
+package loggo_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestWithColor(t *testing.T) {
+	type testCase struct {
+		name    string
+		options []loggo.Option
+		want    string
+	}
+
+	testCases := []testCase{
+		{
+			name: "auto without terminal",
+			want: "2022-01-25 00:00:00 [ WARN]: disk almost full\n",
+		},
+		{
+			name:    "always",
+			options: []loggo.Option{loggo.WithColor(loggo.ColorAlways)},
+			want:    "2022-01-25 00:00:00 [\x1b[33m WARN\x1b[0m]: disk almost full\n",
+		},
+		{
+			name:    "never",
+			options: []loggo.Option{loggo.WithColor(loggo.ColorNever)},
+			want:    "2022-01-25 00:00:00 [ WARN]: disk almost full\n",
+		},
+		{
+			name:    "custom template",
+			options: []loggo.Option{loggo.WithColor(loggo.ColorAlways), loggo.WithTemplate("{{.Color}}{{.Message}}{{.Reset}}")},
+			want:    "\x1b[33mdisk almost full\x1b[0m\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			options := append([]loggo.Option{loggo.WithOutput(w), loggo.WithTimeProvider(fakeNow)}, tc.options...)
+			logger := loggo.New(loggo.LevelInfo, options...)
+			logger.Warn("disk almost full")
+
+			if w.String() != tc.want {
+				t.Errorf("Logger.Warn() = %q, want %q", w.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestWithColor_autoFile(t *testing.T) {
+	file, err := os.Create(t.TempDir() + "/app.log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	logger := loggo.New(loggo.LevelInfo, loggo.WithTemplate("{{.Color}}{{.Message}}"))
+	child := logger.Child(loggo.WithOutput(file))
+	child.Info("plain")
+
+	content, err := os.ReadFile(file.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(content) != "plain\n" {
+		t.Errorf("file content = %q, want %q", content, "plain\n")
+	}
+}
+
+func TestColorMode_Enabled(t *testing.T) {
+	type testCase struct {
+		name       string
+		mode       loggo.ColorMode
+		noColor    string
+		forceColor string
+		want       bool
+	}
+
+	testCases := []testCase{
+		{name: "auto", mode: loggo.ColorAuto, want: false},
+		{name: "auto with CLICOLOR_FORCE", mode: loggo.ColorAuto, forceColor: "1", want: true},
+		{name: "auto with CLICOLOR_FORCE=0", mode: loggo.ColorAuto, forceColor: "0", want: false},
+		{name: "auto with NO_COLOR", mode: loggo.ColorAuto, noColor: "1", forceColor: "1", want: false},
+		{name: "always with NO_COLOR", mode: loggo.ColorAlways, noColor: "1", want: true},
+		{name: "never with CLICOLOR_FORCE", mode: loggo.ColorNever, forceColor: "1", want: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("NO_COLOR", tc.noColor)
+			t.Setenv("CLICOLOR_FORCE", tc.forceColor)
+
+			if got := tc.mode.Enabled(&strings.Builder{}); got != tc.want {
+				t.Errorf("ColorMode.Enabled() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLevel_Color(t *testing.T) {
+	type testCase struct {
+		level loggo.Level
+		want  string
+	}
+
+	testCases := []testCase{
+		{level: loggo.LevelTrace, want: "\x1b[2;90m"},
+		{level: loggo.LevelWarn, want: "\x1b[33m"},
+		{level: loggo.LevelFatal, want: "\x1b[35m"},
+		{level: levelNotice, want: "\x1b[36m"},
+		{level: levelAudit, want: "\x1b[35m"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.level.String(), func(t *testing.T) {
+			if got := tc.level.Color(); got != tc.want {
+				t.Errorf("Color() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
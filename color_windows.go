@@ -0,0 +1,32 @@
+//go:build windows
+
+package loggo
+
+import (
+	"os"
+	"syscall"
+)
+
+// enableVirtualTerminalProcessing is the console mode flag enabling the processing of ANSI escape codes.
+const enableVirtualTerminalProcessing = 0x0004
+
+var setConsoleMode = syscall.NewLazyDLL("kernel32.dll").NewProc("SetConsoleMode")
+
+// enableVirtualTerminal enables the processing of ANSI escape codes on the console, reporting whether it succeeded.
+// It fails on consoles older than Windows 10, and on files that are not consoles.
+func enableVirtualTerminal(f *os.File) bool {
+	handle := syscall.Handle(f.Fd())
+
+	var mode uint32
+	if err := syscall.GetConsoleMode(handle, &mode); err != nil {
+		return false
+	}
+
+	if mode&enableVirtualTerminalProcessing != 0 {
+		return true
+	}
+
+	ok, _, _ := setConsoleMode.Call(uintptr(handle), uintptr(mode|enableVirtualTerminalProcessing))
+
+	return ok != 0
+}
@@ -0,0 +1,100 @@
+package loggo
+
+import (
+	"context"
+)
+
+// contextKey is the key of the Logger in a context.
+type contextKey struct{}
+
+// NewContext returns a copy of the context carrying the Logger, retrieved with FromContext.
+//
+// Parameters:
+//   - ctx: The parent context.
+//   - logger: The Logger to carry.
+//
+// Returns:
+//   - The context carrying the Logger.
+//
+// Example:
+//
+//	ctx = loggo.NewContext(ctx, logger.Child(loggo.WithPreHook(tagRequest)))
+func NewContext(ctx context.Context, logger *Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// FromContext returns the Logger carried by the context with NewContext.
+//
+// Parameters:
+//   - ctx: The context.
+//
+// Returns:
+//   - The Logger carried by the context, nil if none.
+//   - true if the context carries a Logger, false otherwise.
+//
+// Example:
+//
+//	if logger, ok := loggo.FromContext(ctx); ok {
+//		logger.Info("Handling request")
+//	}
+func FromContext(ctx context.Context) (*Logger, bool) {
+	logger, ok := ctx.Value(contextKey{}).(*Logger)
+
+	return logger, ok
+}
+
+// Go runs the function in a new goroutine, with the context and the diagnostic context of the calling goroutine, so
+// background work keeps the Logger carried by the context, and the fields and scopes pushed with PushFields and
+// PushScope, for request correlation.
+//
+// Parameters:
+//   - ctx: The context passed to the function, usually carrying a Logger with NewContext.
+//   - fn: The function to run.
+//
+// Example:
+//
+//	release := loggo.PushFields(loggo.F("request_id", id))
+//	defer release()
+//
+//	loggo.Go(ctx, func(ctx context.Context) {
+//		logger, _ := loggo.FromContext(ctx)
+//		logger.Info("Sending email") // ... Sending email request_id=42
+//	})
+func Go(ctx context.Context, fn func(ctx context.Context)) {
+	fields, scopes := snapshotDiagnostics()
+
+	go func() {
+		if len(fields) > 0 || len(scopes) > 0 {
+			release := push(func(d *diagnostics) func(*diagnostics) {
+				d.fields = append(d.fields, fields...)
+				d.scopes = append(d.scopes, scopes...)
+
+				return func(d *diagnostics) {
+					d.fields, d.scopes = nil, nil
+				}
+			})
+			defer release()
+		}
+
+		fn(ctx)
+	}()
+}
+
+// contextValue returns the value of the context for a name, looked up with the key mapped to the name in the keys,
+// see WithContextKey, or with the name itself. It returns an empty string if there is no value, or no context.
+func contextValue(ctx context.Context, keys map[string]any, name string) any {
+	if ctx == nil {
+		return ""
+	}
+
+	var key any = name
+	if mapped, ok := keys[name]; ok {
+		key = mapped
+	}
+
+	if value := ctx.Value(key); value != nil {
+		return value
+	}
+
+	return ""
+}
@@ -0,0 +1,106 @@
+package loggo_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestFromContext(t *testing.T) {
+	logger := loggo.New(loggo.LevelInfo)
+
+	if _, ok := loggo.FromContext(context.Background()); ok {
+		t.Error("FromContext() of an empty context ok = true, want false")
+	}
+
+	if got, ok := loggo.FromContext(loggo.NewContext(context.Background(), logger)); !ok || got != logger {
+		t.Errorf("FromContext() = %p, %v, want %p, true", got, ok, logger)
+	}
+}
+
+func TestGo(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("[{{.Scope}}] {{.Message}}{{with .Fields}} {{.}}{{end}}"))
+	ctx := loggo.NewContext(context.Background(), logger)
+
+	pop := loggo.PushScope("request")
+	release := loggo.PushFields(loggo.F("request_id", 42))
+	done := make(chan struct{})
+
+	loggo.Go(ctx, func(ctx context.Context) {
+		defer close(done)
+
+		l, _ := loggo.FromContext(ctx)
+		l.Info("background")
+	})
+
+	<-done
+
+	release()
+	pop()
+
+	done = make(chan struct{})
+
+	loggo.Go(ctx, func(ctx context.Context) {
+		defer close(done)
+
+		l, _ := loggo.FromContext(ctx)
+		l.Info("no diagnostics")
+	})
+
+	<-done
+
+	if want := "[request] background request_id=42\n[] no diagnostics\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
+
+type requestIDKey struct{}
+
+func TestTemplate_ctx(t *testing.T) {
+	ctx := context.WithValue(context.Background(), requestIDKey{}, "req-42")
+	ctx = context.WithValue(ctx, "tenant", "acme")
+
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo,
+		loggo.WithOutput(w),
+		loggo.WithContext(ctx),
+		loggo.WithContextKey("request_id", requestIDKey{}),
+		loggo.WithTemplate(`[{{ctx "request_id"}}] [{{ctx "tenant"}}] [{{ctx "missing"}}] {{.Message}}`),
+	)
+	logger.Info("handled")
+
+	if want := "[req-42] [acme] [] handled\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+
+	if err := loggo.ValidateTemplate(`{{ctx "request_id"}} {{.Message}}`); err != nil {
+		t.Errorf("ValidateTemplate() error = %v", err)
+	}
+}
+
+func TestTemplate_ctxCompiledOnce(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo,
+		loggo.WithOutput(w),
+		loggo.WithContext(context.WithValue(context.Background(), requestIDKey{}, "req-1")),
+		loggo.WithContextKey("request_id", requestIDKey{}),
+		loggo.WithTemplate(`[{{ctx "request_id"}}] {{.Message}}`),
+	)
+	child := logger.Child(loggo.WithContext(context.WithValue(context.Background(), requestIDKey{}, "req-2")))
+
+	logger.Info("first")
+	child.Info("child")
+	logger.If(true).Info("second")
+	child.AlsoTo(io.Discard).Info("child again")
+	logger.WithTemplateOnce(`({{ctx "request_id"}}) {{.Message}}`).Info("once")
+	logger.Info("third")
+
+	want := "[req-1] first\n[req-2] child\n[req-1] second\n[req-2] child again\n(req-1) once\n[req-1] third\n"
+	if w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
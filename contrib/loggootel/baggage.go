@@ -0,0 +1,48 @@
+// Package loggootel propagates the OpenTelemetry context of a loggo.Logger into its entries.
+//
+// Only the programs importing it depend on the OpenTelemetry API.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggootel.WithBaggage("tenant", "order_id"))
+//	logger.Child(loggo.WithContext(ctx)).Info("handled") // ... handled tenant=acme order_id=42
+package loggootel
+
+import (
+	"context"
+
+	"github.com/hvpaiva/loggo"
+	"go.opentelemetry.io/otel/baggage"
+)
+
+// WithBaggage configures a Logger to append the members of the OpenTelemetry baggage of its context to each entry, as
+// fields, so the business identifiers set at the edge appear on every downstream log line. Only the allowed members
+// are copied, in the given order, and the members missing from the baggage are skipped.
+//
+// Parameters:
+//   - members: The keys of the allowed baggage members, also used as the keys of the fields.
+//
+// Returns:
+//   - The loggo.Option adding the fields.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggootel.WithBaggage("tenant", "order_id"))
+func WithBaggage(members ...string) loggo.Option {
+	return loggo.WithContextFields(func(ctx context.Context) loggo.Fields {
+		bag := baggage.FromContext(ctx)
+		if bag.Len() == 0 {
+			return nil
+		}
+
+		var fields loggo.Fields
+
+		for _, key := range members {
+			if member := bag.Member(key); member.Key() != "" {
+				fields = append(fields, loggo.F(key, member.Value()))
+			}
+		}
+
+		return fields
+	})
+}
@@ -0,0 +1,33 @@
+package loggootel_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/contrib/loggootel"
+	"go.opentelemetry.io/otel/baggage"
+)
+
+func TestWithBaggage(t *testing.T) {
+	tenant, _ := baggage.NewMember("tenant", "acme")
+	secret, _ := baggage.NewMember("session", "s3cr3t")
+	order, _ := baggage.NewMember("order_id", "42")
+
+	bag, err := baggage.New(tenant, secret, order)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Message}} {{.Fields}}"),
+		loggootel.WithBaggage("order_id", "tenant", "user"))
+
+	logger.Info("no baggage")
+	logger.Child(loggo.WithContext(baggage.ContextWithBaggage(context.Background(), bag))).Info("handled")
+
+	if want := "no baggage \nhandled order_id=42 tenant=acme\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/hvpaiva/loggo/contrib/loggootel
+
+go 1.23.0
+
+require (
+	github.com/hvpaiva/loggo v1.0.0
+	go.opentelemetry.io/otel v1.31.0
+)
+
+replace github.com/hvpaiva/loggo => ../..
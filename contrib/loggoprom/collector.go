@@ -0,0 +1,131 @@
+// Package loggoprom provides a Prometheus collector exposing the metrics of a loggo.Logger.
+//
+// Only the programs importing it depend on the Prometheus client library.
+//
+// Example:
+//
+//	collector := loggoprom.NewCollector("app")
+//	logger := loggo.New(loggo.LevelInfo, collector.Instrument())
+//	prometheus.MustRegister(collector)
+package loggoprom
+
+import (
+	"time"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Collector is a prometheus.Collector exposing the counters and the size histograms of a loggo.Logger, read from its
+// Health when collected, and histograms of the time it takes to render and write the entries.
+type Collector struct {
+	logger  *loggo.Logger
+	entries *prometheus.Desc
+	errors  *prometheus.Desc
+	dropped *prometheus.Desc
+	queue   *prometheus.Desc
+	sizes   *prometheus.Desc
+	encode  prometheus.Histogram
+	write   prometheus.Histogram
+}
+
+// NewCollector returns a Collector, with the metric names prefixed by the namespace, e.g. "app_log_entries_total".
+// The Collector must be attached to a Logger with Instrument.
+//
+// Parameters:
+//   - namespace: The namespace of the metrics, may be empty.
+//
+// Returns:
+//   - A pointer to the Collector.
+func NewCollector(namespace string) *Collector {
+	name := func(n string) string {
+		return prometheus.BuildFQName(namespace, "log", n)
+	}
+
+	return &Collector{
+		entries: prometheus.NewDesc(name("entries_total"), "Number of log entries written, per level.", []string{"level"}, nil),
+		errors:  prometheus.NewDesc(name("errors_total"), "Number of errors writing log entries to the output or a sink.", nil, nil),
+		dropped: prometheus.NewDesc(name("dropped_total"), "Number of log entries lost by the output or a sink.", nil, nil),
+		queue:   prometheus.NewDesc(name("queue_depth"), "Number of log entries waiting in the sinks.", nil, nil),
+		sizes:   prometheus.NewDesc(name("entry_size_bytes"), "Size of the rendered log entries, per level.", []string{"level"}, nil),
+		encode: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    name("encode_duration_seconds"),
+			Help:    "Time taken to render a log entry.",
+			Buckets: prometheus.ExponentialBuckets(1e-6, 4, 10),
+		}),
+		write: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    name("write_duration_seconds"),
+			Help:    "Time taken to write rendered log entries to the outputs and sinks.",
+			Buckets: prometheus.ExponentialBuckets(1e-6, 4, 10),
+		}),
+	}
+}
+
+// Instrument returns a loggo.Option attaching the Collector to the Logger it configures.
+//
+// Returns:
+//   - The loggo.Option to pass to loggo.New.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, collector.Instrument())
+func (c *Collector) Instrument() loggo.Option {
+	return func(l *loggo.Logger) {
+		c.logger = l
+		loggo.WithLatencyObserver(c)(l)
+	}
+}
+
+// ObserveEncode records the time taken to render an entry.
+func (c *Collector) ObserveEncode(d time.Duration) {
+	c.encode.Observe(d.Seconds())
+}
+
+// ObserveWrite records the time taken to write rendered entries.
+func (c *Collector) ObserveWrite(d time.Duration) {
+	c.write.Observe(d.Seconds())
+}
+
+// Describe sends the descriptors of the metrics of the Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.entries
+	ch <- c.errors
+	ch <- c.dropped
+	ch <- c.queue
+	ch <- c.sizes
+	c.encode.Describe(ch)
+	c.write.Describe(ch)
+}
+
+// Collect sends the current metrics of the Logger. Only the histograms are sent before the Collector is attached to
+// a Logger.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.encode.Collect(ch)
+	c.write.Collect(ch)
+
+	if c.logger == nil {
+		return
+	}
+
+	health := c.logger.Health()
+
+	for level, n := range health.Entries {
+		ch <- prometheus.MustNewConstMetric(c.entries, prometheus.CounterValue, float64(n), level.String())
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.errors, prometheus.CounterValue, float64(health.Errors))
+	ch <- prometheus.MustNewConstMetric(c.dropped, prometheus.CounterValue, float64(health.Dropped))
+	ch <- prometheus.MustNewConstMetric(c.queue, prometheus.GaugeValue, float64(health.QueueDepth))
+
+	for level, histogram := range health.Sizes {
+		buckets := make(map[float64]uint64, len(histogram.Bounds))
+
+		var cumulative uint64
+		for i, bound := range histogram.Bounds {
+			cumulative += histogram.Counts[i]
+			buckets[float64(bound)] = cumulative
+		}
+
+		ch <- prometheus.MustNewConstHistogram(c.sizes, histogram.Count, float64(histogram.Sum), buckets, level.String())
+	}
+}
@@ -0,0 +1,73 @@
+package loggoprom_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/contrib/loggoprom"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestCollector(t *testing.T) {
+	collector := loggoprom.NewCollector("app")
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(io.Discard), loggo.WithTemplate("{{.Message}}"), collector.Instrument())
+	logger.Info("first")
+	logger.Info("second")
+	logger.Error("third")
+
+	want := `
+# HELP app_log_entries_total Number of log entries written, per level.
+# TYPE app_log_entries_total counter
+app_log_entries_total{level="ERROR"} 1
+app_log_entries_total{level="INFO"} 2
+# HELP app_log_errors_total Number of errors writing log entries to the output or a sink.
+# TYPE app_log_errors_total counter
+app_log_errors_total 0
+`
+
+	err := testutil.CollectAndCompare(collector, strings.NewReader(want), "app_log_entries_total", "app_log_errors_total")
+	if err != nil {
+		t.Errorf("CollectAndCompare() error = %v", err)
+	}
+
+	wantSizes := `
+# HELP app_log_entry_size_bytes Size of the rendered log entries, per level.
+# TYPE app_log_entry_size_bytes histogram
+app_log_entry_size_bytes_bucket{level="ERROR",le="64"} 1
+app_log_entry_size_bytes_bucket{level="ERROR",le="256"} 1
+app_log_entry_size_bytes_bucket{level="ERROR",le="1024"} 1
+app_log_entry_size_bytes_bucket{level="ERROR",le="4096"} 1
+app_log_entry_size_bytes_bucket{level="ERROR",le="16384"} 1
+app_log_entry_size_bytes_bucket{level="ERROR",le="65536"} 1
+app_log_entry_size_bytes_bucket{level="ERROR",le="262144"} 1
+app_log_entry_size_bytes_bucket{level="ERROR",le="1.048576e+06"} 1
+app_log_entry_size_bytes_bucket{level="ERROR",le="+Inf"} 1
+app_log_entry_size_bytes_sum{level="ERROR"} 6
+app_log_entry_size_bytes_count{level="ERROR"} 1
+app_log_entry_size_bytes_bucket{level="INFO",le="64"} 2
+app_log_entry_size_bytes_bucket{level="INFO",le="256"} 2
+app_log_entry_size_bytes_bucket{level="INFO",le="1024"} 2
+app_log_entry_size_bytes_bucket{level="INFO",le="4096"} 2
+app_log_entry_size_bytes_bucket{level="INFO",le="16384"} 2
+app_log_entry_size_bytes_bucket{level="INFO",le="65536"} 2
+app_log_entry_size_bytes_bucket{level="INFO",le="262144"} 2
+app_log_entry_size_bytes_bucket{level="INFO",le="1.048576e+06"} 2
+app_log_entry_size_bytes_bucket{level="INFO",le="+Inf"} 2
+app_log_entry_size_bytes_sum{level="INFO"} 13
+app_log_entry_size_bytes_count{level="INFO"} 2
+`
+
+	if err := testutil.CollectAndCompare(collector, strings.NewReader(wantSizes), "app_log_entry_size_bytes"); err != nil {
+		t.Errorf("CollectAndCompare() error = %v", err)
+	}
+
+	if n := testutil.CollectAndCount(collector, "app_log_encode_duration_seconds", "app_log_write_duration_seconds"); n != 2 {
+		t.Errorf("CollectAndCount() = %d histograms, want 2", n)
+	}
+
+	if problems, err := testutil.CollectAndLint(collector); err != nil || len(problems) > 0 {
+		t.Errorf("CollectAndLint() = %v, %v", problems, err)
+	}
+}
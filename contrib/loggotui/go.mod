@@ -0,0 +1,12 @@
+module github.com/hvpaiva/loggo/contrib/loggotui
+
+go 1.23.0
+
+require (
+	github.com/hvpaiva/loggo v1.0.0
+	golang.org/x/term v0.25.0
+)
+
+require golang.org/x/sys v0.26.0 // indirect
+
+replace github.com/hvpaiva/loggo => ../..
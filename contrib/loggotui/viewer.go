@@ -0,0 +1,359 @@
+// Package loggotui provides a terminal viewer of the entries of a loggo.Logger, for the local development of
+// concurrent services: the entries are shown live, and can be filtered by level, paused and searched with the
+// keyboard.
+//
+// It depends on golang.org/x/term to drive the terminal, so it is a module of its own.
+//
+// Example:
+//
+//	viewer := loggotui.NewViewer()
+//	logger := loggo.New(loggo.LevelDebug, loggo.WithOutput(io.Discard), loggo.WithSink(viewer))
+//	go runService(logger)
+//
+//	if err := viewer.Run(ctx, os.Stdin, os.Stdout); err != nil {
+//		log.Fatal(err)
+//	}
+package loggotui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hvpaiva/loggo"
+	"golang.org/x/term"
+)
+
+// defaultCapacity is the default number of entries kept by a Viewer.
+const defaultCapacity = 10000
+
+// refreshInterval is the minimum interval between two redraws caused by new entries.
+const refreshInterval = 100 * time.Millisecond
+
+// Keys handled by a Viewer, besides the printable characters.
+const (
+	keyCtrlC     = 0x03
+	keyBackspace = 0x7f
+	keyEscape    = 0x1b
+)
+
+// ANSI escape codes of the screen.
+const (
+	clearScreen = "\x1b[H\x1b[2J"
+	colorReset  = "\x1b[0m"
+	colorInvert = "\x1b[7m"
+)
+
+// maxLevelKeys is the number of levels selected by the keys 1 to 9.
+const maxLevelKeys = 9
+
+// Viewer is a loggo.Sink showing the entries it receives in a terminal, see Run. It keeps the last entries, up to its
+// capacity, so the filters can be changed after the entries are logged. It is safe for concurrent use.
+//
+// The keybindings are:
+//   - 1 to 9: show the entries from the first to the ninth level of loggo.Levels, e.g. 1 for LevelTrace, 3 for
+//     LevelInfo, and the custom levels in their rank;
+//   - space: pause or resume the display, the entries received meanwhile being kept;
+//   - /: search the messages and fields, typing the text and Enter, or Escape to cancel;
+//   - c: clear the entries;
+//   - q or Ctrl-C: quit.
+type Viewer struct {
+	mu       sync.Mutex
+	entries  []loggo.Entry // Ring buffer of the entries kept, the oldest one at head
+	head     int
+	capacity int
+	received uint64 // Number of entries received, the last one being the newest kept
+	updates  chan struct{}
+
+	level     loggo.Level
+	paused    bool
+	pausedAt  uint64 // Number of entries received when the display was paused
+	query     string
+	searching bool
+	input     string // Text of the search being typed
+}
+
+// Option is a function that configures a Viewer.
+type Option func(*Viewer)
+
+// NewViewer returns a Viewer, to be added to a loggo.Logger with loggo.WithSink.
+//
+// Parameters:
+//   - options: Variadic options to configure the Viewer.
+//
+// Returns:
+//   - A pointer to the Viewer.
+func NewViewer(options ...Option) *Viewer {
+	v := &Viewer{capacity: defaultCapacity, updates: make(chan struct{}, 1), level: loggo.LevelTrace}
+
+	for _, option := range options {
+		option(v)
+	}
+
+	return v
+}
+
+// WithCapacity configures the number of entries kept by a Viewer, the oldest being discarded. The default is 10000.
+//
+// Parameters:
+//   - capacity: The number of entries kept.
+//
+// Example:
+//
+//	viewer := loggotui.NewViewer(loggotui.WithCapacity(1000))
+func WithCapacity(capacity int) Option {
+	return func(v *Viewer) {
+		v.capacity = max(capacity, 1)
+	}
+}
+
+// WriteEntry keeps the entry, and notifies Run to redraw the screen.
+func (v *Viewer) WriteEntry(entry loggo.Entry) error {
+	v.mu.Lock()
+	if len(v.entries) < v.capacity {
+		v.entries = append(v.entries, entry)
+	} else {
+		v.entries[v.head] = entry
+		v.head = (v.head + 1) % len(v.entries)
+	}
+
+	v.received++
+	v.mu.Unlock()
+
+	select {
+	case v.updates <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Run shows the entries on the output until the context is done, the input ends, or q is pressed, handling the keys
+// read from the input. When the input is a terminal, it is put in raw mode, restored when Run returns, and the screen
+// is sized to the output terminal, or to 80x24 otherwise.
+//
+// Parameters:
+//   - ctx: The context stopping the viewer.
+//   - in: The input of the keys, usually os.Stdin.
+//   - out: The output of the screen, usually os.Stdout.
+//
+// Returns:
+//   - An error if the terminal could not be put in raw mode, or the screen could not be written, nil otherwise.
+func (v *Viewer) Run(ctx context.Context, in io.Reader, out io.Writer) error {
+	if f, ok := in.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		state, err := term.MakeRaw(int(f.Fd()))
+		if err != nil {
+			return errors.New("error setting raw mode: " + err.Error())
+		}
+
+		defer func() {
+			_ = term.Restore(int(f.Fd()), state)
+		}()
+	}
+
+	keys := make(chan byte)
+	go readKeys(ctx, in, keys)
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+
+	dirty := true
+
+	for {
+		if dirty {
+			if _, err := io.WriteString(out, v.render(screenSize(out))); err != nil {
+				return err
+			}
+
+			dirty = false
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case key, ok := <-keys:
+			if !ok || !v.handleKey(key) {
+				return nil
+			}
+
+			dirty = true
+		case <-ticker.C:
+			select {
+			case <-v.updates:
+				dirty = !v.isPaused()
+			default:
+			}
+		}
+	}
+}
+
+// readKeys sends the bytes read from the input to the keys channel, closing it when the input ends.
+func readKeys(ctx context.Context, in io.Reader, keys chan<- byte) {
+	defer close(keys)
+
+	buf := make([]byte, 64)
+
+	for {
+		n, err := in.Read(buf)
+		for _, b := range buf[:n] {
+			select {
+			case keys <- b:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+// screenSize returns the size of the output terminal, or 80x24 if it is not one.
+func screenSize(out io.Writer) (width, height int) {
+	if f, ok := out.(*os.File); ok {
+		if width, height, err := term.GetSize(int(f.Fd())); err == nil {
+			return width, height
+		}
+	}
+
+	return 80, 24
+}
+
+// isPaused reports whether the display is paused.
+func (v *Viewer) isPaused() bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return v.paused
+}
+
+// handleKey applies the key to the state of the viewer, and reports whether it keeps running.
+func (v *Viewer) handleKey(key byte) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.searching {
+		switch key {
+		case '\r', '\n':
+			v.query, v.searching = v.input, false
+		case keyEscape:
+			v.searching = false
+		case keyBackspace, '\b':
+			if v.input != "" {
+				_, size := utf8.DecodeLastRuneInString(v.input)
+				v.input = v.input[:len(v.input)-size]
+			}
+		case keyCtrlC:
+			return false
+		default:
+			if key >= ' ' {
+				v.input += string([]byte{key})
+			}
+		}
+
+		return true
+	}
+
+	switch {
+	case key == 'q' || key == keyCtrlC:
+		return false
+	case key >= '1' && key < '1'+maxLevelKeys:
+		if levels := loggo.Levels(); int(key-'1') < len(levels) {
+			v.level = levels[key-'1']
+		}
+	case key == ' ':
+		v.paused = !v.paused
+		v.pausedAt = v.received
+	case key == '/':
+		v.searching, v.input = true, ""
+	case key == 'c':
+		v.entries, v.head = v.entries[:0], 0
+	}
+
+	return true
+}
+
+// render returns the screen showing the last entries passing the filters, with a status line at the bottom.
+func (v *Viewer) render(width, height int) string {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	visible := len(v.entries)
+	if v.paused {
+		visible = max(visible-int(v.received-v.pausedAt), 0)
+	}
+
+	var lines []string
+
+	for i := visible - 1; i >= 0 && len(lines) < height-1; i-- {
+		if line, ok := v.format(v.entries[(v.head+i)%len(v.entries)], width); ok {
+			lines = append(lines, line)
+		}
+	}
+
+	var b strings.Builder
+
+	b.WriteString(clearScreen)
+
+	for i := len(lines) - 1; i >= 0; i-- {
+		b.WriteString(lines[i] + "\r\n")
+	}
+
+	b.WriteString(colorInvert + truncate(v.status(), width) + colorReset)
+
+	return b.String()
+}
+
+// format returns the line of the entry, truncated to the width, and whether it passes the filters.
+func (v *Viewer) format(entry loggo.Entry, width int) (string, bool) {
+	text := entry.Message
+	if len(entry.Fields) > 0 {
+		text += " " + entry.Fields.String()
+	}
+
+	if entry.Level.Rank() < v.level.Rank() || !strings.Contains(strings.ToLower(text), strings.ToLower(v.query)) {
+		return "", false
+	}
+
+	text = strings.NewReplacer("\r", " ", "\n", " ").Replace(text)
+	line := truncate(fmt.Sprintf("%s %-5s %s", entry.Time.Format(time.TimeOnly), entry.Level, text), width)
+
+	return entry.Level.Color() + line + colorReset, true
+}
+
+// status returns the status line, with the filters, the state of the display and the keybindings.
+func (v *Viewer) status() string {
+	parts := []string{fmt.Sprintf("level>=%s", v.level), fmt.Sprintf("%d entries", len(v.entries))}
+
+	switch {
+	case v.searching:
+		parts = append(parts, "search: "+v.input+"_")
+	case v.query != "":
+		parts = append(parts, "search: "+v.query)
+	}
+
+	if v.paused {
+		parts = append(parts, fmt.Sprintf("PAUSED (%d new)", v.received-v.pausedAt))
+	}
+
+	keys := min(len(loggo.Levels()), maxLevelKeys)
+	parts = append(parts, fmt.Sprintf("1-%d level, space pause, / search, c clear, q quit", keys))
+
+	return strings.Join(parts, " | ")
+}
+
+// truncate returns the text truncated to the width, in runes.
+func truncate(text string, width int) string {
+	if utf8.RuneCountInString(text) <= width {
+		return text
+	}
+
+	return string([]rune(text)[:max(width, 0)])
+}
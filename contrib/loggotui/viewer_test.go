@@ -0,0 +1,156 @@
+package loggotui_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/contrib/loggotui"
+)
+
+const levelAlert = loggo.Level(35)
+
+func init() {
+	loggo.RegisterLevel(levelAlert, "ALERT")
+}
+
+// lastScreen returns the lines of the last screen written by a Viewer, without their colors.
+func lastScreen(output string) []string {
+	screens := strings.Split(output, "\x1b[H\x1b[2J")
+	screen := screens[len(screens)-1]
+
+	for _, code := range []string{"\x1b[0m", "\x1b[7m", "\x1b[90m", "\x1b[36m", "\x1b[33m", "\x1b[31m"} {
+		screen = strings.ReplaceAll(screen, code, "")
+	}
+
+	return strings.Split(screen, "\r\n")
+}
+
+func TestViewer_Run(t *testing.T) {
+	type testCase struct {
+		name   string
+		keys   string
+		before []string
+		after  []string
+		status string
+	}
+
+	testCases := []testCase{
+		{
+			name:   "all entries",
+			before: []string{"started", "slow query ms=1200", "connection reset"},
+			status: "level>=TRACE | 3 entries",
+		},
+		{
+			name:   "level filter",
+			keys:   "4",
+			before: []string{"slow query ms=1200", "connection reset"},
+			status: "level>=WARN | 3 entries",
+		},
+		{
+			name:   "custom level filter",
+			keys:   "5",
+			before: []string{"connection reset"},
+			status: "level>=ALERT | 3 entries",
+		},
+		{
+			name:   "search",
+			keys:   "/QUERX\x7fY\r",
+			before: []string{"slow query ms=1200"},
+			status: "level>=TRACE | 3 entries | search: QUERY",
+		},
+		{
+			name:   "search canceled",
+			keys:   "/reset\x1b",
+			before: []string{"started", "slow query ms=1200", "connection reset"},
+			status: "level>=TRACE | 3 entries",
+		},
+		{
+			name:   "paused",
+			keys:   " ",
+			before: []string{"started", "slow query ms=1200", "connection reset"},
+			after:  []string{"retrying"},
+			status: "level>=TRACE | 4 entries | PAUSED (1 new)",
+		},
+		{
+			name:   "cleared",
+			keys:   "c",
+			before: nil,
+			status: "level>=TRACE | 0 entries",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			viewer := loggotui.NewViewer()
+			logger := loggo.New(loggo.LevelDebug, loggo.WithOutput(io.Discard), loggo.WithSink(viewer))
+			logger.Debug("started")
+			logger.WarnKV("slow query", "ms", 1200)
+			logger.Error("connection reset")
+
+			keys, w := io.Pipe()
+			out := &strings.Builder{}
+			done := make(chan error)
+
+			go func() {
+				done <- viewer.Run(context.Background(), keys, out)
+			}()
+
+			// The second ignored key is only read once the first one is received, after the keys are handled.
+			_, _ = io.WriteString(w, tc.keys)
+			_, _ = io.WriteString(w, "\x00")
+			_, _ = io.WriteString(w, "\x00")
+
+			for _, message := range tc.after {
+				logger.Info(message)
+			}
+
+			_, _ = io.WriteString(w, "q")
+
+			if err := <-done; err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			screen := lastScreen(out.String())
+			if status := screen[len(screen)-1]; !strings.HasPrefix(status, tc.status+" | 1-8 level") {
+				t.Errorf("status = %q, want %q", status, tc.status)
+			}
+
+			var messages []string
+			for _, line := range screen[:len(screen)-1] {
+				messages = append(messages, strings.Join(strings.Fields(line)[2:], " "))
+			}
+
+			if strings.Join(messages, "\n") != strings.Join(tc.before, "\n") {
+				t.Errorf("messages = %q, want %q", messages, tc.before)
+			}
+		})
+	}
+}
+
+func TestWithCapacity(t *testing.T) {
+	viewer := loggotui.NewViewer(loggotui.WithCapacity(2))
+	logger := loggo.New(loggo.LevelDebug, loggo.WithOutput(io.Discard), loggo.WithSink(viewer))
+
+	for _, message := range []string{"first", "second", "third", "fourth", "fifth"} {
+		logger.Info(message)
+	}
+
+	out := &strings.Builder{}
+	if err := viewer.Run(context.Background(), strings.NewReader("q"), out); err != nil {
+		t.Fatal(err)
+	}
+
+	screen := lastScreen(out.String())
+
+	var messages []string
+	for _, line := range screen[:len(screen)-1] {
+		messages = append(messages, strings.Fields(line)[2])
+	}
+
+	if got, want := strings.Join(messages, ","), "fourth,fifth"; got != want {
+		t.Errorf("messages = %q, want %q", got, want)
+	}
+}
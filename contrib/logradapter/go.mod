@@ -0,0 +1,9 @@
+module github.com/hvpaiva/loggo/contrib/logradapter
+
+go 1.23.0
+
+require github.com/hvpaiva/loggo v1.0.0
+
+require github.com/go-logr/logr v1.4.2
+
+replace github.com/hvpaiva/loggo => ../..
@@ -0,0 +1,164 @@
+// Package logradapter provides a logr.LogSink backed by a loggo.Logger, so the libraries of the Kubernetes ecosystem
+// taking a logr.Logger log through loggo.
+//
+// Only the programs importing it depend on the logr package.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelDebug)
+//	ctrl.SetLogger(logradapter.New(logger))
+package logradapter
+
+import (
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/hvpaiva/loggo"
+)
+
+// LevelFunc maps a logr verbosity level, as given to logr.Logger.V, to a loggo.Level.
+type LevelFunc func(v int) loggo.Level
+
+// DefaultLevels maps the verbosity 0 to loggo.LevelInfo, and the higher verbosities to loggo.LevelDebug.
+func DefaultLevels(v int) loggo.Level {
+	if v <= 0 {
+		return loggo.LevelInfo
+	}
+
+	return loggo.LevelDebug
+}
+
+// LogSink is a logr.LogSink logging to a loggo.Logger. The key/value pairs are logged as fields, the errors as an
+// "error" field, and the names given with logr.Logger.WithName as a "logger" field, joined by "/".
+type LogSink struct {
+	logger *loggo.Logger
+	levels LevelFunc
+	name   string
+	depth  int
+}
+
+// Option is a function that configures a LogSink.
+type Option func(*LogSink)
+
+var (
+	_ logr.LogSink          = (*LogSink)(nil)
+	_ logr.CallDepthLogSink = (*LogSink)(nil)
+)
+
+// New returns a logr.Logger backed by the loggo.Logger.
+//
+// Parameters:
+//   - logger: The loggo.Logger to log to.
+//   - options: Variadic options to configure the LogSink.
+//
+// Returns:
+//   - The logr.Logger.
+//
+// Example:
+//
+//	log := logradapter.New(logger)
+//	log.V(1).Info("reconciling", "pod", name) // logged at loggo.LevelDebug
+func New(logger *loggo.Logger, options ...Option) logr.Logger {
+	return logr.New(NewLogSink(logger, options...))
+}
+
+// NewLogSink returns a LogSink logging to the loggo.Logger. The caller of the entries is the caller of the
+// logr.Logger, replacing the caller skip of the loggo.Logger.
+//
+// Parameters:
+//   - logger: The loggo.Logger to log to.
+//   - options: Variadic options to configure the LogSink.
+//
+// Returns:
+//   - A pointer to the LogSink.
+func NewLogSink(logger *loggo.Logger, options ...Option) *LogSink {
+	s := &LogSink{logger: logger, levels: DefaultLevels}
+
+	for _, option := range options {
+		option(s)
+	}
+
+	return s
+}
+
+// WithLevels configures how a LogSink maps the logr verbosity levels to loggo levels. The default is DefaultLevels.
+//
+// Parameters:
+//   - levels: The LevelFunc mapping the verbosity levels.
+//
+// Example:
+//
+//	log := logradapter.New(logger, logradapter.WithLevels(func(v int) loggo.Level {
+//		return loggo.LevelInfo - loggo.Level(min(v, 1))
+//	}))
+func WithLevels(levels LevelFunc) Option {
+	return func(s *LogSink) {
+		s.levels = levels
+	}
+}
+
+// Init receives the number of call frames the logr package adds above the LogSink, to report the right caller.
+func (s *LogSink) Init(info logr.RuntimeInfo) {
+	s.depth = info.CallDepth
+	s.logger = s.logger.Child(loggo.WithCallerSkip(s.depth + 1))
+}
+
+// Enabled reports whether the entries of the verbosity level pass the Threshold of the loggo.Logger.
+func (s *LogSink) Enabled(level int) bool {
+	return s.levels(level) >= s.logger.GetThreshold()
+}
+
+// Info logs a message at the loggo level of the verbosity level, with the key/value pairs as fields.
+func (s *LogSink) Info(level int, msg string, keysAndValues ...any) {
+	s.logger.LogKV(s.levels(level), msg, s.fields(nil, keysAndValues)...)
+}
+
+// Error logs a message at loggo.LevelError, with the error and the key/value pairs as fields.
+func (s *LogSink) Error(err error, msg string, keysAndValues ...any) {
+	s.logger.LogKV(loggo.LevelError, msg, s.fields(err, keysAndValues)...)
+}
+
+// WithValues returns a copy of the LogSink logging the key/value pairs with every entry.
+func (s *LogSink) WithValues(keysAndValues ...any) logr.LogSink {
+	c := *s
+	c.logger = s.logger.With(keysAndValues...)
+
+	return &c
+}
+
+// WithName returns a copy of the LogSink with the name appended to its name.
+func (s *LogSink) WithName(name string) logr.LogSink {
+	c := *s
+	c.name = strings.TrimPrefix(s.name+"/"+name, "/")
+
+	return &c
+}
+
+// WithCallDepth returns a copy of the LogSink skipping additional call frames to report the caller, for the helpers
+// wrapping a logr.Logger.
+func (s *LogSink) WithCallDepth(depth int) logr.LogSink {
+	c := *s
+	c.depth = s.depth + depth
+	c.logger = s.logger.Child(loggo.WithCallerSkip(c.depth + 1))
+
+	return &c
+}
+
+// fields returns the key/value pairs of an entry, preceded by the name of the LogSink and the error, if any.
+func (s *LogSink) fields(err error, keysAndValues []any) []any {
+	var fields []any
+
+	if s.name != "" {
+		fields = append(fields, loggo.F("logger", s.name))
+	}
+
+	if err != nil {
+		fields = append(fields, loggo.F("error", err))
+	}
+
+	if len(fields) == 0 {
+		return keysAndValues
+	}
+
+	return append(fields, keysAndValues...)
+}
@@ -0,0 +1,86 @@
+package logradapter_test
+
+import (
+	"errors"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/contrib/logradapter"
+)
+
+func TestNew(t *testing.T) {
+	type testCase struct {
+		name    string
+		options []logradapter.Option
+		log     func(log logr.Logger)
+		want    string
+	}
+
+	testCases := []testCase{
+		{
+			name: "levels",
+			log: func(log logr.Logger) {
+				log.Info("info", "pod", "web-1")
+				log.V(1).Info("discarded")
+				log.Error(errors.New("timeout"), "failed", "attempt", 3)
+			},
+			want: "INFO info pod=web-1\nERROR failed error=timeout attempt=3\n",
+		},
+		{
+			name: "custom levels",
+			options: []logradapter.Option{logradapter.WithLevels(func(v int) loggo.Level {
+				return loggo.LevelWarn - loggo.Level(min(v, 2))
+			})},
+			log: func(log logr.Logger) {
+				log.Info("warn")
+				log.V(1).Info("info")
+				log.V(5).Info("discarded")
+			},
+			want: "WARN warn \nINFO info \n",
+		},
+		{
+			name: "values and names",
+			log: func(log logr.Logger) {
+				log.WithName("controller").WithName("pods").WithValues("namespace", "prod").Info("reconciled")
+			},
+			want: "INFO reconciled namespace=prod logger=controller/pods\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w),
+				loggo.WithTemplate("{{.Level}} {{.Message}} {{.Fields}}"))
+
+			tc.log(logradapter.New(logger, tc.options...))
+
+			if w.String() != tc.want {
+				t.Errorf("output = %q, want %q", w.String(), tc.want)
+			}
+		})
+	}
+}
+
+func logHelper(log logr.Logger) {
+	log.WithCallDepth(1).Info("helper")
+}
+
+func TestNew_caller(t *testing.T) {
+	w := &strings.Builder{}
+	log := logradapter.New(loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Caller}}"),
+		loggo.WithCallerFormat(loggo.CallerFormatBase)))
+
+	_, _, line, _ := runtime.Caller(0)
+	log.Info("direct")
+	logHelper(log)
+
+	want := "logsink_test.go:" + strconv.Itoa(line+1) + "\nlogsink_test.go:" + strconv.Itoa(line+2) + "\n"
+	if w.String() != want {
+		t.Errorf("callers = %q, want %q", w.String(), want)
+	}
+}
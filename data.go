@@ -1,37 +1,161 @@
 package loggo
 
 import (
+	"context"
 	"fmt"
+	"runtime"
+	"time"
 )
 
-// templateData is a structure that holds the data for a log message template.
+// templateData is the data of a log message template: the Entry, with its level and time rendered as strings, and the
+// rendering details of the Logger. The Entry itself is available as {{.Entry}}, e.g. {{.Entry.Time.Unix}}.
 type templateData struct {
-	Level   string
-	Time    string
-	Message string
-	Caller  string
+	Entry
+	Level      string
+	Severity   Severity
+	Time       string
+	TimeBucket string
+	Color      string
+	Reset      string
+	Custom     map[string]any
+}
+
+// TemplateDataProvider is a function providing a custom value of the template data for an entry, rendered by
+// {{.Custom.<name>}}, see WithTemplateData.
+type TemplateDataProvider func(entry Entry) any
+
+// dataProvider is a named TemplateDataProvider.
+type dataProvider struct {
+	name     string
+	provider TemplateDataProvider
+}
+
+// newEntry returns the log entry for a message, with the fields of the diagnostic context of the calling goroutine,
+// followed by the fields of the logger, the given fields, the dynamic and context fields of the logger, and the field
+// of its time bucket. It must be called by Logger.log, or at the same stack depth, for the caller to be right.
+func newEntry(level Level, message string, logger *Logger, fields ...Field) Entry {
+	caller, function := getCaller(logger)
+
+	return newEntryAt(level, message, entryOrigin{caller, function, getTime(logger), logger.Context}, logger, fields...)
+}
+
+// newSummaryEntry returns the log entry for a summary written by the logger itself, such as the one of Close, with an
+// unknown caller, as no call of the application logs it.
+func newSummaryEntry(level Level, message string, logger *Logger) Entry {
+	return newEntryAt(level, message, entryOrigin{"unknown", "", getTime(logger), logger.Context}, logger)
+}
+
+// entryOrigin is the origin of a log entry: the caller and the full function name logging it, its time and its
+// context.
+type entryOrigin struct {
+	caller   string
+	function string
+	time     time.Time
+	ctx      context.Context
+}
+
+// newEntryAt returns the log entry for a message logged at the origin, see newEntry.
+func newEntryAt(level Level, message string, origin entryOrigin, logger *Logger, fields ...Field) Entry {
+	diagnosticFields, scope := currentDiagnostics()
+
+	entry := Entry{
+		Level:    level,
+		Time:     origin.time,
+		Message:  truncateString(message, logger.maxSize),
+		Caller:   origin.caller,
+		Function: shortFunctionName(origin.function),
+		Service:  logger.service,
+		Build:    logger.build,
+		Fields:   diagnosticFields,
+		Scope:    scope,
+		Schema:   logger.schema,
+		Context:  origin.ctx,
+	}
+
+	if len(logger.fields) > 0 {
+		entry.Fields = append(entry.Fields, logger.fields...)
+	}
+
+	if len(fields) > 0 {
+		entry.Fields = append(entry.Fields, fields...)
+	}
+
+	if extra := logger.deadlineFields(origin.ctx); extra != nil {
+		entry.Fields = append(entry.Fields, extra...)
+	}
+
+	for _, fn := range logger.fieldFuncs {
+		entry.Fields = append(entry.Fields, fn()...)
+	}
+
+	for _, fn := range logger.ctxFields {
+		entry.Fields = append(entry.Fields, fn(origin.ctx)...)
+	}
+
+	if logger.timeBucket.Window > 0 && logger.timeBucket.Field != "" {
+		entry.Fields = append(entry.Fields, F(logger.timeBucket.Field, logger.bucket(entry.Time)))
+	}
+
+	if logger.idGenerator != nil {
+		entry.ID = logger.idGenerator(entry.Time)
+	}
+
+	if logger.component {
+		entry.Component = componentName(origin.function, logger.componentPrefix)
+	}
+
+	return entry
 }
 
 // getTemplateData returns the data for a log message template.
-func getTemplateData(level Level, message string, logger *Logger) templateData {
+func getTemplateData(entry Entry, logger *Logger) templateData {
 	data := templateData{
-		Level:   level.String(),
-		Time:    logger.now().Format(logger.timeFormat),
-		Message: truncateString(message, logger.maxSize),
-		Caller:  getCaller(logger.callerProvider),
+		Entry:    entry,
+		Level:    entry.Level.String(),
+		Severity: severityOf(logger.severity, entry.Level),
+		Time:     formatTime(entry.Time, logger.timeFormat),
+	}
+
+	if logger.timeBucket.Window > 0 {
+		data.TimeBucket = fmt.Sprint(logger.bucket(entry.Time))
+	}
+
+	if logger.colored {
+		data.Color = entry.Level.Color()
+		data.Reset = colorReset
+	}
+
+	if len(logger.dataProviders) > 0 {
+		data.Custom = make(map[string]any, len(logger.dataProviders))
+		for _, p := range logger.dataProviders {
+			data.Custom[p.name] = p.provider(entry)
+		}
 	}
 
 	return data
 }
 
-// getCaller returns the file and line number of the caller.
-func getCaller(cp CallerProvider) string {
-	_, file, line, ok := cp()
+// getCaller returns the file and line number, and the full function name of the caller.
+// The function name is empty if the caller is unknown.
+func getCaller(logger *Logger) (caller string, function string) {
+	var (
+		pc   uintptr
+		file string
+		line int
+		ok   bool
+	)
+
+	if logger.callerProvider != nil {
+		pc, file, line, ok = logger.callerProvider()
+	} else {
+		pc, file, line, ok = runtime.Caller(callerDepth + logger.callerSkip)
+	}
+
 	if !ok {
-		return "unknown"
+		return "unknown", ""
 	}
 
-	return fmt.Sprintf("%s:%d", file, line)
+	return fmt.Sprintf("%s:%d", logger.callerFormat.path(file), line), functionName(pc)
 }
 
 // truncateString truncates the input string to the specified maxSize.
@@ -0,0 +1,137 @@
+package loggo
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// DeadLetter is an entry a sink failed to receive, as written to the dead-letter output of a Logger.
+type DeadLetter struct {
+	Sink  string `json:"sink"`  // Type of the sink that failed, e.g. "*collector.Client"
+	Error string `json:"error"` // Error returned by the sink on its last attempt
+	Entry Entry  `json:"entry"` // Entry the sink failed to receive
+}
+
+// ReadDeadLetters reads the dead letters written to a dead-letter output, so the failed entries can be reprocessed,
+// e.g. with Replay.
+//
+// Parameters:
+//   - r: The reader of the dead-letter output.
+//
+// Returns:
+//   - The dead letters read.
+//   - An error if the output could not be read or has a malformed line, nil otherwise.
+//
+// Example:
+//
+//	letters, err := loggo.ReadDeadLetters(file)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	for _, letter := range letters {
+//		_ = collector.WriteEntry(letter.Entry)
+//	}
+func ReadDeadLetters(r io.Reader) ([]DeadLetter, error) {
+	var letters []DeadLetter
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+
+	for line := 1; scanner.Scan(); line++ {
+		var letter DeadLetter
+		if err := json.Unmarshal(scanner.Bytes(), &letter); err != nil {
+			return letters, fmt.Errorf("error reading dead letter on line %d: %w", line, err)
+		}
+
+		letters = append(letters, letter)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return letters, errors.New("error reading dead letters: " + err.Error())
+	}
+
+	return letters, nil
+}
+
+// ErrWriteTimeout is the error of the sink writes that exceeded the write timeout of a Logger, see WithWriteTimeout.
+var ErrWriteTimeout = errors.New("write timed out")
+
+// writeSink sends the entry to the sink, retrying it up to the sink retries of the logger, unless it timed out. When
+// every attempt fails, the entry is written to the dead-letter output, if any. The lock must be held.
+func (l *Logger) writeSink(s *sinkState, entry Entry) error {
+	var err error
+
+	for attempt := 0; attempt <= l.sinkRetries; attempt++ {
+		if err = l.writeEntry(s, entry); err == nil {
+			s.lastWrite = l.health.clock.Now()
+
+			return nil
+		}
+
+		if errors.Is(err, ErrWriteTimeout) {
+			break
+		}
+	}
+
+	s.lastError = err
+	s.failures++
+
+	sinkErr := errors.New("error writing to sink: " + err.Error())
+
+	if l.deadLetter == nil {
+		l.health.recordError(sinkErr, 1)
+
+		return sinkErr
+	}
+
+	line, marshalErr := json.Marshal(DeadLetter{Sink: fmt.Sprintf("%T", s.sink), Error: err.Error(), Entry: entry})
+	if marshalErr == nil {
+		_, marshalErr = l.deadLetter.Write(append(line, '\n'))
+	}
+
+	if marshalErr != nil {
+		sinkErr = errors.New(sinkErr.Error() + ", error writing dead letter: " + marshalErr.Error())
+		l.health.recordError(sinkErr, 1)
+
+		return sinkErr
+	}
+
+	l.health.recordError(sinkErr, 0)
+
+	return sinkErr
+}
+
+// writeEntry sends the entry to the sink, waiting for it up to the write timeout of the logger, if any. A timed out
+// write keeps running in the background, and the entries sent to the sink until it returns time out immediately.
+func (l *Logger) writeEntry(s *sinkState, entry Entry) error {
+	if l.writeTimeout <= 0 {
+		return s.sink.WriteEntry(entry)
+	}
+
+	if !s.pending.CompareAndSwap(false, true) {
+		return ErrWriteTimeout
+	}
+
+	done := make(chan error, 1)
+
+	go func() {
+		defer s.pending.Store(false)
+
+		done <- s.sink.WriteEntry(entry)
+	}()
+
+	timer := time.NewTimer(l.writeTimeout)
+	defer timer.Stop()
+
+	select {
+	case err := <-done:
+		return err
+	case <-timer.C:
+		return ErrWriteTimeout
+	}
+}
@@ -0,0 +1,149 @@
+package loggo_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+func TestLogger_LogE_deadLetter(t *testing.T) {
+	var deadLetters bytes.Buffer
+
+	failing := &failAfterSink{limit: -1}
+	observer := &loggotest.Observer{}
+	logger := loggo.New(loggo.LevelInfo,
+		loggo.WithOutput(&loggotest.Recorder{}),
+		loggo.WithTimeProvider(fakeNow),
+		loggo.WithSink(failing),
+		loggo.WithSink(observer),
+		loggo.WithSinkRetries(2),
+		loggo.WithDeadLetter(&deadLetters),
+	)
+
+	err := logger.LogE(loggo.LevelWarn, "disk almost full")
+	if err == nil || err.Error() != "error writing to sink: connection reset" {
+		t.Errorf("Logger.LogE() error = %v, want %q", err, "error writing to sink: connection reset")
+	}
+
+	if len(observer.Entries()) != 1 {
+		t.Errorf("other sink received %d entries, want 1", len(observer.Entries()))
+	}
+
+	letters, err := loggo.ReadDeadLetters(&deadLetters)
+	if err != nil {
+		t.Fatalf("ReadDeadLetters() error = %v", err)
+	}
+
+	if len(letters) != 1 {
+		t.Fatalf("ReadDeadLetters() = %d letters, want 1", len(letters))
+	}
+
+	letter := letters[0]
+	if letter.Sink != "*loggo_test.failAfterSink" || letter.Error != "connection reset" {
+		t.Errorf("dead letter = %+v, want sink *loggo_test.failAfterSink and error connection reset", letter)
+	}
+
+	if letter.Entry.Level != loggo.LevelWarn || letter.Entry.Message != "disk almost full" || !letter.Entry.Time.Equal(fakeNow()) {
+		t.Errorf("dead letter entry = %+v, want the logged entry", letter.Entry)
+	}
+}
+
+func TestLogger_LogE_sinkRetries(t *testing.T) {
+	sink := &retrySink{failures: 2}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(&loggotest.Recorder{}), loggo.WithSink(sink), loggo.WithSinkRetries(2))
+
+	if err := logger.LogE(loggo.LevelInfo, "retried"); err != nil {
+		t.Errorf("Logger.LogE() error = %v, want nil", err)
+	}
+
+	if sink.attempts != 3 {
+		t.Errorf("sink attempts = %d, want 3", sink.attempts)
+	}
+}
+
+func TestLogger_LogE_deadLetterError(t *testing.T) {
+	logger := loggo.New(loggo.LevelInfo,
+		loggo.WithOutput(&loggotest.Recorder{}),
+		loggo.WithSink(&failAfterSink{limit: -1}),
+		loggo.WithDeadLetter(errorWriter{}),
+	)
+
+	err := logger.LogE(loggo.LevelInfo, "lost")
+	if err == nil || !strings.Contains(err.Error(), "error writing dead letter") {
+		t.Errorf("Logger.LogE() error = %v, want a dead letter error", err)
+	}
+}
+
+func TestReadDeadLetters_malformed(t *testing.T) {
+	_, err := loggo.ReadDeadLetters(strings.NewReader("{}\nnot json\n"))
+	if err == nil || !strings.HasPrefix(err.Error(), "error reading dead letter on line 2") {
+		t.Errorf("ReadDeadLetters() error = %v, want an error on line 2", err)
+	}
+}
+
+// retrySink is a Sink failing a number of times before receiving the entries.
+type retrySink struct {
+	failures int
+	attempts int
+}
+
+func (s *retrySink) WriteEntry(loggo.Entry) error {
+	s.attempts++
+	if s.attempts <= s.failures {
+		return errors.New("timeout")
+	}
+
+	return nil
+}
+
+type hungSink struct {
+	release chan struct{}
+	calls   atomic.Int32
+}
+
+func (s *hungSink) WriteEntry(loggo.Entry) error {
+	s.calls.Add(1)
+	<-s.release
+
+	return nil
+}
+
+func TestWithWriteTimeout(t *testing.T) {
+	var deadLetters bytes.Buffer
+
+	hung := &hungSink{release: make(chan struct{})}
+	logger := loggo.New(loggo.LevelInfo,
+		loggo.WithOutput(&loggotest.Recorder{}),
+		loggo.WithSink(hung),
+		loggo.WithSinkRetries(2),
+		loggo.WithWriteTimeout(10*time.Millisecond),
+		loggo.WithDeadLetter(&deadLetters),
+	)
+
+	for _, message := range []string{"first", "second"} {
+		if err := logger.LogE(loggo.LevelInfo, message); err == nil || err.Error() != "error writing to sink: write timed out" {
+			t.Errorf("Logger.LogE() error = %v, want %q", err, "error writing to sink: write timed out")
+		}
+	}
+
+	close(hung.release)
+
+	letters, err := loggo.ReadDeadLetters(&deadLetters)
+	if err != nil {
+		t.Fatalf("ReadDeadLetters() error = %v", err)
+	}
+
+	if len(letters) != 2 || letters[0].Error != "write timed out" || letters[1].Entry.Message != "second" {
+		t.Errorf("dead letters = %+v, want the two timed out entries", letters)
+	}
+
+	if calls := hung.calls.Load(); calls != 1 {
+		t.Errorf("sink calls = %d, want 1, as the pending write must not be retried or doubled", calls)
+	}
+}
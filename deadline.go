@@ -0,0 +1,32 @@
+package loggo
+
+import "context"
+
+// escalate returns the level of a message logged after the context is done, when deadline warnings are enabled:
+// LevelWarn for the levels below it, the level itself otherwise.
+func (l *Logger) escalate(ctx context.Context, level Level) Level {
+	if l.deadlineWarn && level.Rank() < LevelWarn.Rank() && ctx.Err() != nil {
+		return LevelWarn
+	}
+
+	return level
+}
+
+// deadlineFields returns the fields noting the remaining deadline of the context, or the reason it is done, when
+// deadline warnings are enabled.
+func (l *Logger) deadlineFields(ctx context.Context) Fields {
+	if !l.deadlineWarn {
+		return nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return Fields{F("context", err.Error())}
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nil
+	}
+
+	return Fields{F("deadline_ms", deadline.Sub(l.clock.Now()).Milliseconds())}
+}
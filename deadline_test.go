@@ -0,0 +1,60 @@
+package loggo_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+func TestWithDeadlineWarnings(t *testing.T) {
+	deadline := time.Now().Add(time.Hour)
+	clock := loggotest.NewClockAt(deadline.Add(-120 * time.Millisecond))
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo,
+		loggo.WithOutput(w),
+		loggo.WithClock(clock),
+		loggo.WithTemplate("{{.Level}} {{.Message}}{{with .Fields}} {{.}}{{end}}"),
+		loggo.WithContext(ctx),
+		loggo.WithDeadlineWarnings(),
+	)
+
+	logger.Info("querying")
+	logger.Debug("discarded")
+
+	cancel()
+
+	logger.Info("late")
+	logger.Debug("late debug")
+	logger.Error("failed")
+
+	batch := logger.Batch()
+	batch.Info("late batch")
+	_ = batch.Flush()
+
+	want := "INFO querying deadline_ms=120\n" +
+		"WARN late context=\"context canceled\"\n" +
+		"WARN late debug context=\"context canceled\"\n" +
+		"ERROR failed context=\"context canceled\"\n" +
+		"WARN late batch context=\"context canceled\"\n"
+
+	if w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
+
+func TestWithDeadlineWarnings_noDeadline(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Message}}{{with .Fields}} {{.}}{{end}}"), loggo.WithDeadlineWarnings())
+	logger.Info("no deadline")
+
+	if want := "no deadline\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
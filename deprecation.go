@@ -0,0 +1,56 @@
+package loggo
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// deprecations is the registry of the deprecation messages already logged by the process.
+var deprecations = struct {
+	sync.Mutex
+	seen     map[string]struct{}
+	silenced atomic.Bool
+}{seen: map[string]struct{}{}}
+
+// Deprecation logs a deprecation message at LevelWarn, with the field deprecated=true, only the first time the message
+// is logged by the process, by any Logger, so deprecated flags and APIs are reported without flooding the logs. A
+// message discarded by the Threshold of the Logger is not remembered, and can still be logged by another Logger. The
+// deprecations can be silenced with SilenceDeprecations.
+//
+// Parameters:
+//   - message: The deprecation message.
+//
+// Example:
+//
+//	if *oldFlag != "" {
+//		logger.Deprecation("flag --old is deprecated, use --new")
+//	}
+func (l *Logger) Deprecation(message string) {
+	if deprecations.silenced.Load() || l.off() || l.discards(LevelWarn) {
+		return
+	}
+
+	deprecations.Lock()
+	_, seen := deprecations.seen[message]
+	deprecations.seen[message] = struct{}{}
+	deprecations.Unlock()
+
+	if seen {
+		return
+	}
+
+	_ = l.log(LevelWarn, text(message), F("deprecated", true))
+}
+
+// SilenceDeprecations configures whether the deprecation messages logged with Logger.Deprecation are discarded, for
+// every Logger of the process, e.g. with a --no-deprecation-warnings flag. It is safe for concurrent use.
+//
+// Parameters:
+//   - silenced: Whether the deprecation messages are discarded.
+//
+// Example:
+//
+//	loggo.SilenceDeprecations(os.Getenv("NO_DEPRECATION_WARNINGS") != "")
+func SilenceDeprecations(silenced bool) {
+	deprecations.silenced.Store(silenced)
+}
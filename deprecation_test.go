@@ -0,0 +1,33 @@
+package loggo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestLogger_Deprecation(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithFormat(loggo.FormatLogfmt),
+		loggo.WithTimeProvider(fakeNow), loggo.WithCallerProvider(func() (uintptr, string, int, bool) {
+			return 0, "app.go", 7, true
+		}))
+	quiet := logger.Child(loggo.WithThreshold(loggo.LevelError))
+
+	quiet.Deprecation("flag --old is deprecated, use --new")
+	logger.Deprecation("flag --old is deprecated, use --new")
+	logger.Deprecation("flag --old is deprecated, use --new")
+	logger.Child().Deprecation("flag --old is deprecated, use --new")
+	logger.Deprecation("flag --legacy is deprecated")
+
+	loggo.SilenceDeprecations(true)
+	logger.Deprecation("flag --ancient is deprecated")
+	loggo.SilenceDeprecations(false)
+
+	want := `time="2022-01-25 00:00:00" level=WARN msg="flag --old is deprecated, use --new" caller=app.go:7 deprecated=true` +
+		"\n" + `time="2022-01-25 00:00:00" level=WARN msg="flag --legacy is deprecated" caller=app.go:7 deprecated=true` + "\n"
+	if w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
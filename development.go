@@ -0,0 +1,75 @@
+package loggo
+
+import (
+	"fmt"
+)
+
+// checkConfig panics if the configuration of the logger is invalid and the logger is in development mode: a nil
+// output or sink, or a template that does not compile.
+func (l *Logger) checkConfig() {
+	if !l.development {
+		return
+	}
+
+	if l.output == nil {
+		misuse("nil output")
+	}
+
+	for _, output := range l.extraOutputs {
+		if output == nil {
+			misuse("nil output")
+		}
+	}
+
+	for _, s := range l.sinks {
+		if s.sink == nil {
+			misuse("nil sink")
+		}
+	}
+
+	if l.format == FormatText && l.encoder == nil {
+		if _, err := l.compiledTemplate(); err != nil {
+			misuse(err.Error())
+		}
+	}
+}
+
+// checkOpen panics if the logger is closed, once Close logged its summaries, and in development mode.
+func (l *Logger) checkOpen() {
+	if !l.development {
+		return
+	}
+
+	l.mu.Lock()
+	drained := l.health.drained
+	l.mu.Unlock()
+
+	if drained {
+		misuse("logging after Close")
+	}
+}
+
+// checkKeyvals panics if the alternating keys and values have a key without a value and the logger is in development
+// mode.
+func (l *Logger) checkKeyvals(keyvals []any) {
+	if !l.development {
+		return
+	}
+
+	for i := 0; i < len(keyvals); i++ {
+		if _, ok := keyvals[i].(Field); ok {
+			continue
+		}
+
+		if i+1 == len(keyvals) {
+			misuse(fmt.Sprintf("key %v without a value", keyvals[i]))
+		}
+
+		i++
+	}
+}
+
+// misuse panics with the description of a misuse of a logger.
+func misuse(description string) {
+	panic("loggo: " + description)
+}
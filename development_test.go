@@ -0,0 +1,94 @@
+package loggo_test
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestWithDevelopment(t *testing.T) {
+	type testCase struct {
+		name      string
+		misuse    func(options ...loggo.Option)
+		wantPanic string
+	}
+
+	testCases := []testCase{
+		{
+			name: "logging after Close",
+			misuse: func(options ...loggo.Option) {
+				logger := loggo.New(loggo.LevelInfo, append(options, loggo.WithSummary())...)
+				_ = logger.Close()
+				logger.Info("late")
+			},
+			wantPanic: "loggo: logging after Close",
+		},
+		{
+			name: "key without value",
+			misuse: func(options ...loggo.Option) {
+				loggo.New(loggo.LevelInfo, options...).DebugKV("discarded", loggo.F("ok", 1), "user")
+			},
+			wantPanic: "loggo: key user without a value",
+		},
+		{
+			name: "With key without value",
+			misuse: func(options ...loggo.Option) {
+				loggo.New(loggo.LevelInfo, options...).With("request_id")
+			},
+			wantPanic: "loggo: key request_id without a value",
+		},
+		{
+			name: "nil output",
+			misuse: func(options ...loggo.Option) {
+				loggo.New(loggo.LevelInfo, append(options, loggo.WithOutput(nil))...)
+			},
+			wantPanic: "loggo: nil output",
+		},
+		{
+			name: "nil extra output",
+			misuse: func(options ...loggo.Option) {
+				loggo.New(loggo.LevelInfo, options...).AlsoTo(nil)
+			},
+			wantPanic: "loggo: nil output",
+		},
+		{
+			name: "nil sink",
+			misuse: func(options ...loggo.Option) {
+				loggo.New(loggo.LevelInfo, options...).Child(loggo.WithSink(nil))
+			},
+			wantPanic: "loggo: nil sink",
+		},
+		{
+			name: "invalid template",
+			misuse: func(options ...loggo.Option) {
+				loggo.New(loggo.LevelInfo, append(options, loggo.WithTemplate("{{.Message"))...)
+			},
+			wantPanic: "loggo: error parsing template: template: log:1: unclosed action",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Without the development mode, the misuse is tolerated.
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						t.Errorf("misuse panicked without the development mode: %v", r)
+					}
+				}()
+
+				tc.misuse(loggo.WithOutput(io.Discard))
+			}()
+
+			defer func() {
+				if r := recover(); fmt.Sprint(r) != tc.wantPanic {
+					t.Errorf("panic = %v, want %q", r, tc.wantPanic)
+				}
+			}()
+
+			tc.misuse(loggo.WithOutput(io.Discard), loggo.WithDevelopment())
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package loggo
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// diffMask is the rendering of the values of masked fields in diffs.
+const diffMask = "***"
+
+// diffNone is the rendering of the values missing from one side of a diff, such as an absent map key.
+const diffNone = "<none>"
+
+// Diff logs a message at the given log level with a field per value changed between before and after, such as two
+// versions of a configuration, instead of dumping both. The fields are keyed by the path of the changed value, e.g.
+// "db.pool.max", with "<before> -> <after>" values. Structs and maps are compared recursively, other values, including
+// slices, as a whole. The values of the struct fields tagged `loggo:"mask"` are masked, and the struct fields tagged
+// `loggo:"-"` are ignored. Nothing is logged if the log level is below the Threshold.
+//
+// Parameters:
+//   - level: The log level of the message.
+//   - message: The message to log.
+//   - before: The previous value.
+//   - after: The current value.
+//
+// Example:
+//
+//	type Config struct {
+//		Addr     string
+//		Password string `loggo:"mask"`
+//	}
+//
+//	logger.Diff(loggo.LevelInfo, "config reloaded", oldCfg, newCfg)
+//	// Output: 2024-09-03 15:04:05 [ INFO]: config reloaded Addr=":80 -> :8080" Password="*** -> ***"
+func (l *Logger) Diff(level Level, message string, before, after any) {
+	if l.off() || l.discards(level) {
+		return
+	}
+
+	var fields Fields
+	diffValues("", reflect.ValueOf(before), reflect.ValueOf(after), false, &fields)
+
+	_ = l.log(level, text(message), fields...)
+}
+
+// diffValues appends to the fields the values changed between before and after, at the path.
+func diffValues(path string, before, after reflect.Value, masked bool, fields *Fields) {
+	before, after = indirect(before), indirect(after)
+
+	switch {
+	case !before.IsValid() && !after.IsValid():
+		return
+	case !before.IsValid() || !after.IsValid() || before.Type() != after.Type():
+	case before.Kind() == reflect.Struct:
+		diffStructs(path, before, after, masked, fields)
+
+		return
+	case before.Kind() == reflect.Map:
+		diffMaps(path, before, after, masked, fields)
+
+		return
+	case reflect.DeepEqual(before.Interface(), after.Interface()):
+		return
+	}
+
+	if path == "" {
+		path = "value"
+	}
+
+	*fields = append(*fields, F(path, diffValue(before, masked)+" -> "+diffValue(after, masked)))
+}
+
+// diffStructs appends to the fields the exported struct fields changed between before and after.
+func diffStructs(path string, before, after reflect.Value, masked bool, fields *Fields) {
+	for i := 0; i < before.NumField(); i++ {
+		field := before.Type().Field(i)
+		tag := field.Tag.Get("loggo")
+
+		if !field.IsExported() || tag == "-" {
+			continue
+		}
+
+		diffValues(joinPath(path, field.Name), before.Field(i), after.Field(i), masked || tag == "mask", fields)
+	}
+}
+
+// diffMaps appends to the fields the map entries changed between before and after, sorted by key.
+func diffMaps(path string, before, after reflect.Value, masked bool, fields *Fields) {
+	keys := map[string]reflect.Value{}
+	for _, m := range []reflect.Value{before, after} {
+		for _, key := range m.MapKeys() {
+			keys[fmt.Sprint(key.Interface())] = key
+		}
+	}
+
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		key := keys[name]
+		diffValues(joinPath(path, name), before.MapIndex(key), after.MapIndex(key), masked, fields)
+	}
+}
+
+// indirect returns the value pointed to by pointers and interfaces, or an invalid value for nil ones.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+
+		v = v.Elem()
+	}
+
+	return v
+}
+
+// diffValue renders a value of a diff.
+func diffValue(v reflect.Value, masked bool) string {
+	switch {
+	case !v.IsValid():
+		return diffNone
+	case masked:
+		return diffMask
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
+
+// joinPath returns the path of an element of the value at the path.
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
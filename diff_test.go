@@ -0,0 +1,110 @@
+package loggo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+type diffPool struct {
+	Max int
+	Min int
+}
+
+type diffConfig struct {
+	Addr     string
+	Password string `loggo:"mask"`
+	Internal string `loggo:"-"`
+	Pool     *diffPool
+	Labels   map[string]string
+	Hosts    []string
+	secret   string
+}
+
+func TestLogger_Diff(t *testing.T) {
+	before := diffConfig{
+		Addr:     ":80",
+		Password: "old",
+		Internal: "a",
+		Pool:     &diffPool{Max: 10, Min: 1},
+		Labels:   map[string]string{"team": "core", "tier": "1"},
+		Hosts:    []string{"a"},
+		secret:   "a",
+	}
+
+	type testCase struct {
+		name   string
+		level  loggo.Level
+		before any
+		after  any
+		want   string
+	}
+
+	testCases := []testCase{
+		{
+			name:   "changed",
+			level:  loggo.LevelInfo,
+			before: before,
+			after: diffConfig{
+				Addr:     ":8080",
+				Password: "new",
+				Internal: "b",
+				Pool:     &diffPool{Max: 20, Min: 1},
+				Labels:   map[string]string{"team": "core", "zone": "eu"},
+				Hosts:    []string{"a", "b"},
+				secret:   "b",
+			},
+			want: `config reloaded Addr=":80 -> :8080" Password="*** -> ***" Pool.Max="10 -> 20" ` +
+				`Labels.tier="1 -> <none>" Labels.zone="<none> -> eu" Hosts="[a] -> [a b]"` + "\n",
+		},
+		{
+			name:   "pointers",
+			level:  loggo.LevelInfo,
+			before: &before,
+			after:  &diffConfig{Addr: ":80", Password: "old", Pool: &diffPool{Max: 10, Min: 1}, Hosts: []string{"a"}},
+			want:   `config reloaded Labels.team="core -> <none>" Labels.tier="1 -> <none>"` + "\n",
+		},
+		{
+			name:   "nil pointer",
+			level:  loggo.LevelInfo,
+			before: diffConfig{},
+			after:  diffConfig{Pool: &diffPool{}},
+			want:   `config reloaded Pool="<none> -> {0 0}"` + "\n",
+		},
+		{
+			name:   "scalars",
+			level:  loggo.LevelInfo,
+			before: 1,
+			after:  2,
+			want:   `config reloaded value="1 -> 2"` + "\n",
+		},
+		{
+			name:   "unchanged",
+			level:  loggo.LevelInfo,
+			before: before,
+			after:  before,
+			want:   "config reloaded \n",
+		},
+		{
+			name:   "below threshold",
+			level:  loggo.LevelDebug,
+			before: 1,
+			after:  2,
+			want:   "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Message}} {{.Fields}}"))
+
+			logger.Diff(tc.level, "config reloaded", tc.before, tc.after)
+
+			if w.String() != tc.want {
+				t.Errorf("output = %q, want %q", w.String(), tc.want)
+			}
+		})
+	}
+}
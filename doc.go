@@ -3,5 +3,5 @@
 //
 // The loggo package allows you to create a Logger with a specified log level
 // Threshold and various options to customize its behavior. You can log messages
-// at different levels such as Debug, Info, Warn, Error, and Fatal.
+// at different levels such as Trace, Debug, Info, Warn, Error, Panic, and Fatal.
 package loggo
@@ -0,0 +1,49 @@
+package loggo
+
+import (
+	"sync"
+)
+
+// DropReason is the reason an entry was dropped before being written.
+type DropReason string
+
+// Available drop reasons.
+const (
+	// DropFiltered is the reason of the entries discarded by a filter, see WithFilter.
+	DropFiltered DropReason = "filtered"
+	// DropSuppressed is the reason of the entries suppressed by an aggregation, see WithAggregation.
+	DropSuppressed DropReason = "suppressed"
+	// DropTransformed is the reason of the entries discarded by a transformer, such as a sampler, see WithTransformer.
+	DropTransformed DropReason = "transformed"
+)
+
+// Drop describes an entry dropped before being written.
+type Drop struct {
+	Entry  Entry      // Entry dropped
+	Reason DropReason // Reason the entry was dropped
+	Count  uint64     // Number of entries dropped for the reason so far, including this one
+}
+
+// DropHook is a function called for every entry dropped before being written, so suppressed volume can be accounted
+// for, e.g. in metrics. It is called synchronously, and must not log to the same logger.
+type DropHook func(drop Drop)
+
+// drops counts the dropped entries by reason, shared by a logger and the loggers derived from it.
+type drops struct {
+	mu     sync.Mutex
+	counts map[DropReason]uint64
+}
+
+// drop calls the drop hook of the logger, if any, for an entry dropped for the reason.
+func (l *Logger) drop(entry Entry, reason DropReason) {
+	if l.dropHook == nil {
+		return
+	}
+
+	l.drops.mu.Lock()
+	l.drops.counts[reason]++
+	count := l.drops.counts[reason]
+	l.drops.mu.Unlock()
+
+	l.dropHook(Drop{Entry: entry, Reason: reason, Count: count})
+}
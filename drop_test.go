@@ -0,0 +1,37 @@
+package loggo_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+func TestWithDropHook(t *testing.T) {
+	var drops []string
+
+	logger, _ := loggotest.New(loggo.LevelInfo, loggo.WithOutput(&loggotest.Recorder{}),
+		loggo.WithDropHook(func(d loggo.Drop) {
+			drops = append(drops, string(d.Reason)+":"+d.Entry.Message+":"+strings.Repeat("+", int(d.Count)))
+		}),
+		loggo.WithFilter(func(entry loggo.Entry) bool { return entry.Message != "healthcheck" }),
+		loggo.WithAggregation(loggo.LevelWarn, time.Hour, true),
+		loggo.WithTransformer("sampler", func(entry loggo.Entry) (loggo.Entry, bool) {
+			return entry, entry.Message != "sampled"
+		}))
+	defer logger.Close()
+
+	logger.Info("healthcheck")
+	logger.Warn("retrying")
+	logger.Info("sampled")
+	logger.Info("kept")
+	logger.Child().Info("healthcheck")
+
+	want := []string{"filtered:healthcheck:+", "suppressed:retrying:+", "transformed:sampled:+", "filtered:healthcheck:++"}
+	if !reflect.DeepEqual(drops, want) {
+		t.Errorf("drops = %q, want %q", drops, want)
+	}
+}
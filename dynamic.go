@@ -0,0 +1,128 @@
+package loggo
+
+import (
+	"errors"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+)
+
+// dynamicLevel reloads the threshold of a logger from a file when the process receives a reload signal.
+type dynamicLevel struct {
+	variable  string         // Environment variable holding the name of the level
+	path      string         // File holding the name of the level, empty for none
+	signals   chan os.Signal // Reload signal notifications, nil if the level is never reloaded
+	done      chan struct{}  // Closed to stop the reloads
+	stopped   chan struct{}  // Closed once the reloads are stopped
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+// WithDynamicLevel configures a Logger to read its Threshold from the name of a level, e.g. "debug", so operators can
+// change the verbosity of a process without rebuilding it. The name is read when the Logger is created from the file
+// named by the environment variable suffixed by "_FILE", e.g. LOGGO_LEVEL_FILE=/run/app/level, if set, and from the
+// environment variable itself otherwise, e.g. LOGGO_LEVEL=debug. On Unix systems, the file is read again whenever the
+// process receives SIGHUP, so the verbosity of a running process can be changed by updating the file; SIGHUP is left
+// untouched when no file is set. The Threshold given to New is kept while the name is empty, and an unknown level or
+// an unreadable file is reported to the error output of the Logger, see WithErrorOutput. The loggers derived from the
+// Logger follow its Threshold, unless configured with WithThreshold. The reloads stop when the Logger is closed.
+//
+// Parameters:
+//   - variable: The environment variable holding the name of the level, e.g. "LOGGO_LEVEL".
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithDynamicLevel("LOGGO_LEVEL"))
+//	defer logger.Close()
+//
+//	// $ LOGGO_LEVEL_FILE=/run/app/level ./app
+//	// $ echo debug > /run/app/level && kill -HUP $(pidof app)
+func WithDynamicLevel(variable string) Option {
+	return func(l *Logger) {
+		l.dynamicLevel = &dynamicLevel{variable: variable}
+	}
+}
+
+// startDynamicLevel reads the threshold of the logger from the dynamic level, if any, and starts reloading it from its
+// file on the reload signals, unless it is already started.
+func (l *Logger) startDynamicLevel() {
+	d := l.dynamicLevel
+	if d == nil {
+		return
+	}
+
+	d.startOnce.Do(func() {
+		l.threshold = newThreshold(l.GetThreshold())
+		d.path = os.Getenv(d.variable + "_FILE")
+		l.reloadLevel()
+
+		if d.path == "" || len(reloadSignals) == 0 {
+			return
+		}
+
+		d.signals = make(chan os.Signal, 1)
+		d.done = make(chan struct{})
+		d.stopped = make(chan struct{})
+		signal.Notify(d.signals, reloadSignals...)
+
+		go func() {
+			defer close(d.stopped)
+
+			for {
+				select {
+				case <-d.signals:
+					l.reloadLevel()
+				case <-d.done:
+					signal.Stop(d.signals)
+
+					return
+				}
+			}
+		}()
+	})
+}
+
+// stop stops reloading the threshold of the loggers on the reload signals, if the dynamic level is started.
+func (d *dynamicLevel) stop() {
+	if d == nil || d.signals == nil {
+		return
+	}
+
+	d.stopOnce.Do(func() {
+		close(d.done)
+	})
+	<-d.stopped
+}
+
+// reloadLevel sets the threshold of the logger to the level read from the file of its dynamic level, or from its
+// environment variable if it has no file, if any.
+func (l *Logger) reloadLevel() {
+	d := l.dynamicLevel
+	source, name := d.variable, os.Getenv(d.variable)
+
+	if d.path != "" {
+		data, err := os.ReadFile(d.path)
+		if err != nil {
+			l.reportError(errors.New("error reading level file: " + err.Error()))
+
+			return
+		}
+
+		source, name = d.path, string(data)
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return
+	}
+
+	level, err := ParseLevel(name)
+	if err != nil {
+		l.reportError(errors.New("error reading " + source + ": " + err.Error()))
+
+		return
+	}
+
+	l.SetThreshold(level)
+}
@@ -0,0 +1,11 @@
+//go:build !unix
+
+package loggo
+
+import (
+	"os"
+)
+
+// reloadSignals are the signals reloading the dynamic levels of the loggers, none on this platform, where the dynamic
+// levels are only read when the loggers are created.
+var reloadSignals []os.Signal
@@ -0,0 +1,116 @@
+//go:build unix
+
+package loggo_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestWithDynamicLevel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "level")
+	writeLevel(t, path, "warn\n")
+	t.Setenv("LOGGO_TEST_LEVEL", "error")
+	t.Setenv("LOGGO_TEST_LEVEL_FILE", path)
+
+	logger := loggo.New(loggo.LevelInfo, loggo.WithDynamicLevel("LOGGO_TEST_LEVEL"))
+	derived := logger.With("component", "db")
+	independent := logger.Child(loggo.WithThreshold(loggo.LevelError))
+
+	if got := logger.GetThreshold(); got != loggo.LevelWarn {
+		t.Fatalf("GetThreshold() = %s, want WARN from the file", got)
+	}
+
+	writeLevel(t, path, "debug\n")
+
+	if err := syscall.Kill(syscall.Getpid(), syscall.SIGHUP); err != nil {
+		t.Fatal(err)
+	}
+
+	waitThreshold(t, derived, loggo.LevelDebug)
+
+	if got := independent.GetThreshold(); got != loggo.LevelError {
+		t.Errorf("GetThreshold() of the independent child = %s, want ERROR", got)
+	}
+
+	if err := logger.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWithDynamicLevel_fallback(t *testing.T) {
+	type testCase struct {
+		name    string
+		value   string
+		file    string
+		want    loggo.Level
+		wantErr string
+	}
+
+	missing := filepath.Join(t.TempDir(), "missing")
+
+	testCases := []testCase{
+		{name: "unset", value: "", want: loggo.LevelError},
+		{name: "environment", value: "debug", want: loggo.LevelDebug},
+		{
+			name:    "unknown",
+			value:   "loud",
+			want:    loggo.LevelError,
+			wantErr: "loggo: error reading LOGGO_TEST_LEVEL: unknown level \"loud\"\n",
+		},
+		{
+			name:    "missing file",
+			value:   "debug",
+			file:    missing,
+			want:    loggo.LevelError,
+			wantErr: "loggo: error reading level file: open " + missing + ": no such file or directory\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("LOGGO_TEST_LEVEL", tc.value)
+			t.Setenv("LOGGO_TEST_LEVEL_FILE", tc.file)
+
+			errs := &strings.Builder{}
+			logger := loggo.New(loggo.LevelError, loggo.WithDynamicLevel("LOGGO_TEST_LEVEL"), loggo.WithErrorOutput(errs))
+			defer logger.Close()
+
+			if got := logger.GetThreshold(); got != tc.want {
+				t.Errorf("GetThreshold() = %s, want %s", got, tc.want)
+			}
+
+			if errs.String() != tc.wantErr {
+				t.Errorf("error output = %q, want %q", errs.String(), tc.wantErr)
+			}
+		})
+	}
+}
+
+// writeLevel writes the name of a level to the file.
+func writeLevel(t *testing.T, path, name string) {
+	t.Helper()
+
+	if err := os.WriteFile(path, []byte(name), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// waitThreshold waits for the threshold of the logger to be set to the level by a reload.
+func waitThreshold(t *testing.T, logger *loggo.Logger, level loggo.Level) {
+	t.Helper()
+
+	for deadline := time.Now().Add(5 * time.Second); logger.GetThreshold() != level; {
+		if time.Now().After(deadline) {
+			t.Fatalf("GetThreshold() = %s, want %s", logger.GetThreshold(), level)
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,11 @@
+//go:build unix
+
+package loggo
+
+import (
+	"os"
+	"syscall"
+)
+
+// reloadSignals are the signals reloading the dynamic levels of the loggers, see WithDynamicLevel.
+var reloadSignals = []os.Signal{syscall.SIGHUP}
@@ -0,0 +1,290 @@
+package loggo
+
+import (
+	"bytes"
+	"errors"
+	"html"
+	"strconv"
+	"strings"
+)
+
+// Encoder encodes the entries of a Logger as log lines, see WithEncoder. The Logger resolves the entries, their
+// caller, time, truncated message and fields, before encoding them, so an Encoder only implements a wire format.
+type Encoder interface {
+	// Encode returns the entry encoded as a log line, without the line ending.
+	Encode(entry Entry) ([]byte, error)
+}
+
+// Format is the format of the lines written by a Logger, rendered by one of the built-in encoders.
+type Format int
+
+const (
+	// FormatText renders the lines with the template of the Logger. It is the default format.
+	FormatText Format = iota
+	// FormatJSON renders the lines with a JSONEncoder.
+	FormatJSON
+	// FormatHTML renders the lines with an HTMLEncoder.
+	FormatHTML
+	// FormatMarkdown renders the lines with a MarkdownEncoder.
+	FormatMarkdown
+	// FormatLogfmt renders the lines with a LogfmtEncoder.
+	FormatLogfmt
+)
+
+// JSONEncoder is an Encoder rendering each entry as a JSON object, with the "time", "level", "message" and "caller"
+// keys, followed by the "function", "component", "id", "scope", "schema", "service", "build" and "fields" keys when
+// they are known. An epoch time format renders the time as a number. With a SeverityProfile, the "severity" and
+// "severity_number" keys follow the "level" key. The "caller" and "function" keys are left out of the entries below
+// CallerLevel.
+type JSONEncoder struct {
+	TimeFormat  string          // Format of the time, TimeFormatDefault if empty
+	Severity    SeverityProfile // Profile of the severity keys, none if nil
+	CallerLevel Level           // Minimum level of the entries with the caller keys, every entry if zero
+}
+
+// LogfmtEncoder is an Encoder rendering each entry in logfmt, as key=value pairs with the "time", "level", "msg" and
+// "caller" keys, followed by the fields, as expected by aggregators such as Grafana Loki and Heroku. With a
+// SeverityProfile, the "severity" and "severity_number" keys follow the "level" key.
+type LogfmtEncoder struct {
+	TimeFormat string          // Format of the time, TimeFormatDefault if empty
+	Severity   SeverityProfile // Profile of the severity keys, none if nil
+}
+
+// HTMLEncoder is an Encoder rendering each entry as an HTML div, with the time, a badge of the level colored like the
+// terminal output, the message in monospace and the fields, for reports and web pages embedding log excerpts.
+type HTMLEncoder struct {
+	TimeFormat string // Format of the time, TimeFormatDefault if empty
+}
+
+// MarkdownEncoder is an Encoder rendering each entry as a Markdown list item, with the time, an emoji badge of the
+// level, the message in monospace and the fields. The newlines of the message and fields are rendered as spaces.
+type MarkdownEncoder struct {
+	TimeFormat string // Format of the time, TimeFormatDefault if empty
+}
+
+// templateEncoder is the Encoder rendering the template of a logger, with its colors, context and custom data.
+type templateEncoder struct {
+	logger *Logger
+}
+
+// levelHTMLColors are the background colors of the HTML level badges, matching the terminal colors.
+var levelHTMLColors = map[Level]string{
+	LevelTrace: "#adb5bd",
+	LevelDebug: "#6c757d",
+	LevelInfo:  "#17a2b8",
+	LevelWarn:  "#e0a800",
+	LevelError: "#dc3545",
+	LevelPanic: "#b21f2d",
+	LevelFatal: "#a626a4",
+}
+
+// levelBadges are the emoji badges of the Markdown levels, matching the terminal colors.
+var levelBadges = map[Level]string{
+	LevelTrace: "⚫",
+	LevelDebug: "⚪",
+	LevelInfo:  "🔵",
+	LevelWarn:  "🟡",
+	LevelError: "🔴",
+	LevelPanic: "🟤",
+	LevelFatal: "🟣",
+}
+
+// lineEncoder returns the Encoder of the logger: the one configured with WithEncoder, or the built-in encoder of its
+// format, rendering the time in its time format and the severities of its SeverityProfile.
+func (l *Logger) lineEncoder() Encoder {
+	if l.encoder != nil {
+		return l.encoder
+	}
+
+	switch l.format {
+	case FormatJSON:
+		return JSONEncoder{TimeFormat: l.timeFormat, Severity: l.severity}
+	case FormatHTML:
+		return HTMLEncoder{TimeFormat: l.timeFormat}
+	case FormatMarkdown:
+		return MarkdownEncoder{TimeFormat: l.timeFormat}
+	case FormatLogfmt:
+		return LogfmtEncoder{TimeFormat: l.timeFormat, Severity: l.severity}
+	default:
+		return templateEncoder{logger: l}
+	}
+}
+
+// encoderTime returns the time in the format, or in TimeFormatDefault if the format is empty.
+func encoderTime(entry Entry, format string) string {
+	if format == "" {
+		format = TimeFormatDefault
+	}
+
+	return formatTime(entry.Time, format)
+}
+
+// Encode renders the template of the logger for the entry.
+func (e templateEncoder) Encode(entry Entry) ([]byte, error) {
+	c := e.logger.compile()
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	var buf bytes.Buffer
+	if err := c.execute(&buf, getTemplateData(entry, e.logger), e.logger.contextKeys); err != nil {
+		return nil, errors.New("error executing template: " + err.Error())
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Encode returns the entry as a JSON object.
+func (e JSONEncoder) Encode(entry Entry) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString(`{"time":`)
+	if epoch, ok := epochTime(entry.Time, e.TimeFormat); ok {
+		buf.WriteString(strconv.FormatInt(epoch, 10))
+	} else {
+		buf.WriteString(jsonValue(encoderTime(entry, e.TimeFormat)))
+	}
+
+	writeJSONKey(&buf, "level", entry.Level.String())
+	if e.Severity != nil {
+		severity := e.Severity(entry.Level)
+		writeJSONKey(&buf, "severity", severity.Name)
+		writeJSONKey(&buf, "severity_number", severity.Number)
+	}
+
+	writeJSONKey(&buf, "message", entry.Message)
+
+	var function string
+	if e.CallerLevel == 0 || entry.Level.Rank() >= e.CallerLevel.Rank() {
+		writeJSONKey(&buf, "caller", entry.Caller)
+		function = entry.Function
+	}
+
+	optional := []struct {
+		key   string
+		value string
+	}{
+		{"function", function},
+		{"component", entry.Component},
+		{"id", entry.ID},
+		{"scope", entry.Scope},
+		{"schema", entry.Schema},
+	}
+	for _, o := range optional {
+		if o.value != "" && o.value != "unknown" {
+			writeJSONKey(&buf, o.key, o.value)
+		}
+	}
+
+	if entry.Service != (Service{}) {
+		writeJSONKey(&buf, "service", entry.Service)
+	}
+
+	if entry.Build != (Build{}) {
+		writeJSONKey(&buf, "build", entry.Build)
+	}
+
+	if len(entry.Fields) > 0 {
+		buf.WriteString(`,"fields":{`)
+		for i, f := range entry.Fields {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			buf.WriteString(jsonValue(f.Key))
+			buf.WriteByte(':')
+			buf.WriteString(jsonValue(f.Value))
+		}
+		buf.WriteByte('}')
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// writeJSONKey writes a key and its value, encoded as JSON, to the buffer, preceded by a comma.
+func writeJSONKey(buf *bytes.Buffer, key string, value any) {
+	buf.WriteByte(',')
+	buf.WriteString(jsonValue(key))
+	buf.WriteByte(':')
+	buf.WriteString(jsonValue(value))
+}
+
+// Encode returns the entry in logfmt, quoting the values when needed.
+func (e LogfmtEncoder) Encode(entry Entry) ([]byte, error) {
+	pairs := Fields{
+		F("time", encoderTime(entry, e.TimeFormat)),
+		F("level", entry.Level.String()),
+	}
+
+	if e.Severity != nil {
+		severity := e.Severity(entry.Level)
+		pairs = append(pairs, F("severity", severity.Name), F("severity_number", severity.Number))
+	}
+
+	pairs = append(pairs, F("msg", entry.Message), F("caller", entry.Caller))
+
+	return []byte(append(pairs, entry.Fields...).String()), nil
+}
+
+// Encode returns the entry as an HTML div, escaping its text.
+func (e HTMLEncoder) Encode(entry Entry) ([]byte, error) {
+	var buf bytes.Buffer
+
+	level := entry.Level.String()
+
+	buf.WriteString(`<div class="loggo loggo-` + strings.ToLower(level) + `"><time>`)
+	buf.WriteString(html.EscapeString(encoderTime(entry, e.TimeFormat)))
+	buf.WriteString(`</time> <span class="loggo-level" style="background-color:` + levelHTMLColors[entry.Level.base()] +
+		`;color:#fff;border-radius:3px;padding:0 4px">` + level + `</span> <code>`)
+	buf.WriteString(strings.ReplaceAll(html.EscapeString(entry.Message), "\n", "<br>"))
+	buf.WriteString(`</code>`)
+
+	if len(entry.Fields) > 0 {
+		buf.WriteString(` <code class="loggo-fields">` + html.EscapeString(entry.Fields.String()) + `</code>`)
+	}
+
+	buf.WriteString(`</div>`)
+
+	return buf.Bytes(), nil
+}
+
+// Encode returns the entry as a Markdown list item.
+func (e MarkdownEncoder) Encode(entry Entry) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString("- " + encoderTime(entry, e.TimeFormat) + " " + levelBadges[entry.Level.base()] + " **")
+	buf.WriteString(entry.Level.String() + "** ")
+	buf.WriteString(markdownCode(entry.Message))
+
+	if len(entry.Fields) > 0 {
+		buf.WriteString(" " + markdownCode(entry.Fields.String()))
+	}
+
+	return buf.Bytes(), nil
+}
+
+// markdownCode returns the text as a Markdown code span, delimited by more backticks than the text contains in a row,
+// with its newlines replaced by spaces.
+func markdownCode(text string) string {
+	text = strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(text)
+
+	longest, run := 0, 0
+	for _, r := range text {
+		if r != '`' {
+			run = 0
+			continue
+		}
+
+		run++
+		longest = max(longest, run)
+	}
+
+	fence := strings.Repeat("`", longest+1)
+	if longest > 0 {
+		return fence + " " + text + " " + fence
+	}
+
+	return fence + text + fence
+}
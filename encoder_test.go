@@ -0,0 +1,221 @@
+package loggo_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestWithFormat_json(t *testing.T) {
+	type testCase struct {
+		name    string
+		options []loggo.Option
+		fields  []loggo.Field
+		message string
+		want    string
+	}
+
+	testCases := []testCase{
+		{
+			name:    "escaping",
+			message: "line \"one\"\nline two",
+			want:    `{"time":"2022-01-25 00:00:00","level":"INFO","message":"line \"one\"\nline two","caller":"app.go:7"}`,
+		},
+		{
+			name:    "fields",
+			message: "failed",
+			fields:  []loggo.Field{loggo.F("user", 42), loggo.F("err", errors.New("timeout"))},
+			want: `{"time":"2022-01-25 00:00:00","level":"INFO","message":"failed","caller":"app.go:7",` +
+				`"fields":{"user":42,"err":"timeout"}}`,
+		},
+		{
+			name:    "epoch time",
+			options: []loggo.Option{loggo.WithTimeFormat(loggo.TimeFormatEpochSeconds)},
+			message: "started",
+			want:    `{"time":1643068800,"level":"INFO","message":"started","caller":"app.go:7"}`,
+		},
+		{
+			name:    "service",
+			options: []loggo.Option{loggo.WithService("checkout", "1.4.2", "")},
+			message: "started",
+			want: `{"time":"2022-01-25 00:00:00","level":"INFO","message":"started","caller":"app.go:7",` +
+				`"service":{"name":"checkout","version":"1.4.2"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			options := append([]loggo.Option{
+				loggo.WithOutput(w),
+				loggo.WithTimeProvider(fakeNow),
+				loggo.WithFormat(loggo.FormatJSON),
+				loggo.WithCallerProvider(func() (uintptr, string, int, bool) { return 0, "app.go", 7, true }),
+			}, tc.options...)
+			logger := loggo.New(loggo.LevelInfo, options...)
+
+			release := loggo.PushFields(tc.fields...)
+			logger.Info(tc.message)
+			release()
+
+			got := strings.TrimSuffix(w.String(), "\n")
+			if got != tc.want {
+				t.Errorf("output = %s, want %s", got, tc.want)
+			}
+
+			if !json.Valid([]byte(got)) {
+				t.Errorf("output is not valid JSON: %s", got)
+			}
+		})
+	}
+}
+
+func TestWithFormat_templateOnce(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithFormat(loggo.FormatJSON))
+
+	logger.WithTemplateOnce("== {{.Message}} ==").Info("banner")
+
+	if want := "== banner ==\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
+
+func TestWithFormat_report(t *testing.T) {
+	type testCase struct {
+		name    string
+		format  loggo.Format
+		level   loggo.Level
+		message string
+		want    string
+	}
+
+	testCases := []testCase{
+		{
+			name:    "html",
+			format:  loggo.FormatHTML,
+			level:   loggo.LevelError,
+			message: "<b>failed</b>\nretrying",
+			want: `<div class="loggo loggo-error"><time>2022-01-25 00:00:00</time> <span class="loggo-level" ` +
+				`style="background-color:#dc3545;color:#fff;border-radius:3px;padding:0 4px">ERROR</span> ` +
+				`<code>&lt;b&gt;failed&lt;/b&gt;<br>retrying</code> <code class="loggo-fields">user=42</code></div>`,
+		},
+		{
+			name:    "markdown",
+			format:  loggo.FormatMarkdown,
+			level:   loggo.LevelInfo,
+			message: "started\nnow",
+			want:    "- 2022-01-25 00:00:00 🔵 **INFO** `started now` `user=42`",
+		},
+		{
+			name:    "markdown backticks",
+			format:  loggo.FormatMarkdown,
+			level:   loggo.LevelWarn,
+			message: "run `make`",
+			want:    "- 2022-01-25 00:00:00 🟡 **WARN** `` run `make` `` `user=42`",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTimeProvider(fakeNow),
+				loggo.WithFormat(tc.format))
+
+			release := loggo.PushFields(loggo.F("user", 42))
+			logger.Log(tc.level, tc.message)
+			release()
+
+			if got := strings.TrimSuffix(w.String(), "\n"); got != tc.want {
+				t.Errorf("output = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWithFormat_logfmt(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTimeProvider(fakeNow),
+		loggo.WithFormat(loggo.FormatLogfmt),
+		loggo.WithCallerProvider(func() (uintptr, string, int, bool) { return 0, "app.go", 7, true }))
+
+	release := loggo.PushFields(loggo.F("user", 42), loggo.F("path", "/a b"))
+	logger.Warn(`said "hi"`)
+	release()
+
+	want := `time="2022-01-25 00:00:00" level=WARN msg="said \"hi\"" caller=app.go:7 user=42 path="/a b"` + "\n"
+	if w.String() != want {
+		t.Errorf("output = %s, want %s", w.String(), want)
+	}
+}
+
+// csvEncoder is an Encoder rendering the level and message of each entry as CSV.
+type csvEncoder struct{}
+
+func (csvEncoder) Encode(entry loggo.Entry) ([]byte, error) {
+	if entry.Message == "" {
+		return nil, errors.New("empty message")
+	}
+
+	return []byte(entry.Level.String() + "," + strconv.Quote(entry.Message)), nil
+}
+
+func TestWithEncoder(t *testing.T) {
+	type testCase struct {
+		name    string
+		encoder loggo.Encoder
+		message string
+		want    string
+		wantErr string
+	}
+
+	testCases := []testCase{
+		{
+			name:    "custom",
+			encoder: csvEncoder{},
+			message: "started",
+			want:    "INFO,\"started\"\n",
+		},
+		{
+			name:    "custom failure",
+			encoder: csvEncoder{},
+			wantErr: "empty message",
+		},
+		{
+			name:    "built-in",
+			encoder: loggo.LogfmtEncoder{TimeFormat: loggo.TimeFormatEpochSeconds},
+			message: "started",
+			want:    "time=1643068800 level=INFO msg=started caller=app.go:7\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTimeProvider(fakeNow),
+				loggo.WithTemplate("ignored"), loggo.WithEncoder(tc.encoder),
+				loggo.WithCallerProvider(func() (uintptr, string, int, bool) { return 0, "app.go", 7, true }))
+
+			err := logger.LogE(loggo.LevelInfo, tc.message)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Errorf("Logger.LogE() error = %v, want %q", err, tc.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Logger.LogE() error = %v", err)
+			}
+
+			if w.String() != tc.want {
+				t.Errorf("output = %q, want %q", w.String(), tc.want)
+			}
+		})
+	}
+}
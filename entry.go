@@ -0,0 +1,32 @@
+package loggo
+
+import (
+	"context"
+	"time"
+)
+
+// Entry is a log entry, the unit passed through the filters, transformers, encoders and sinks of a Logger, and
+// rendered by its template. The Context is not encoded, nor forwarded by a NetSink.
+type Entry struct {
+	Level     Level     `json:"level"`               // Log level of the entry
+	Time      time.Time `json:"time"`                // Time of the entry, in the location of the Logger
+	Message   string    `json:"message"`             // Message, truncated to the maximum size of the Logger
+	Caller    string    `json:"caller"`              // Caller of the log call, as "file:line", or "unknown"
+	Function  string    `json:"function"`            // Function of the log call, e.g. "pkg.(*Type).Method"
+	Component string    `json:"component,omitempty"` // Component of the log call, from its package, if enabled
+	Service   Service   `json:"service"`             // Service emitting the entry, if configured
+	Build     Build     `json:"build"`               // Build of the binary emitting the entry, if enabled
+	ID        string    `json:"id,omitempty"`        // Unique ID of the entry, if enabled
+	Fields    Fields    `json:"fields,omitempty"`    // Fields of the entry, such as the ones of PushFields
+	Scope     string    `json:"scope,omitempty"`     // Scopes pushed with PushScope, as "outer>inner"
+	Schema    string    `json:"schema,omitempty"`    // Schema version, if set with WithSchemaVersion
+
+	Context context.Context `json:"-"` // Context of the Logger the entry was logged with
+}
+
+// Sink receives the entries logged by a Logger, after they are written to its output.
+// Only the entries at or above the Threshold of the Logger are sent to its sinks.
+type Sink interface {
+	// WriteEntry receives a log entry. It may retain the entry, as the Logger never reuses the entry or its fields.
+	WriteEntry(entry Entry) error
+}
@@ -0,0 +1,76 @@
+package loggo_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestEntry_json(t *testing.T) {
+	type testCase struct {
+		name  string
+		entry loggo.Entry
+		want  string
+	}
+
+	testCases := []testCase{
+		{
+			name: "full",
+			entry: loggo.Entry{
+				Level:     loggo.LevelWarn,
+				Time:      time.Date(2022, 1, 25, 10, 0, 0, 0, time.UTC),
+				Message:   "slow",
+				Caller:    "main.go:12",
+				Function:  "main.main",
+				Component: "api",
+				Service:   loggo.Service{Name: "checkout", Version: "1.4.2"},
+				Build:     loggo.Build{Revision: "abc", GoVersion: "go1.23.0"},
+				ID:        "7",
+				Fields:    loggo.Fields{loggo.F("took", "2s")},
+				Scope:     "request",
+				Schema:    "1",
+			},
+			want: `{"level":"WARN","time":"2022-01-25T10:00:00Z","message":"slow","caller":"main.go:12",` +
+				`"function":"main.main","component":"api","service":{"name":"checkout","version":"1.4.2"},` +
+				`"build":{"revision":"abc","go_version":"go1.23.0"},"id":"7","fields":[{"key":"took","value":"2s"}],` +
+				`"scope":"request","schema":"1"}`,
+		},
+		{
+			name: "minimal",
+			entry: loggo.Entry{
+				Level:    loggo.LevelInfo,
+				Time:     time.Date(2022, 1, 25, 10, 0, 0, 0, time.UTC),
+				Message:  "started",
+				Caller:   "unknown",
+				Function: "unknown",
+			},
+			want: `{"level":"INFO","time":"2022-01-25T10:00:00Z","message":"started","caller":"unknown",` +
+				`"function":"unknown","service":{},"build":{}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			line, err := json.Marshal(tc.entry)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+
+			if string(line) != tc.want {
+				t.Errorf("json.Marshal() = %s, want %s", line, tc.want)
+			}
+
+			var got loggo.Entry
+			if err := json.Unmarshal(line, &got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tc.entry) {
+				t.Errorf("json.Unmarshal() = %+v, want %+v", got, tc.entry)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package loggo
+
+import (
+	"io"
+	"os"
+)
+
+// fatalExitCode is the exit code passed to the exit function of a Logger by the Fatal methods.
+const fatalExitCode = 1
+
+// syncer is implemented by the outputs buffering writes in the operating system, such as a File or an *os.File.
+type syncer interface {
+	Sync() error
+}
+
+// exitFatal flushes the sinks and syncs the outputs of the logger, then calls its exit function, whether the fatal
+// message was logged or not, unless the exit function is disabled, or the logger is a discarding copy returned by If,
+// whose calls are skipped like the ones of a false if statement.
+func (l *Logger) exitFatal() {
+	if l.exit == nil || l.disabled {
+		return
+	}
+
+	l.flush()
+	l.exit(fatalExitCode)
+}
+
+// panicMessage panics with the message, whether it was logged or not, unless the logger is a discarding copy returned
+// by If, see exitFatal.
+func (l *Logger) panicMessage(message string) {
+	if l.disabled {
+		return
+	}
+
+	panic(message)
+}
+
+// flush flushes the sinks implementing a Flush method and syncs the outputs implementing a Sync method, ignoring
+// their errors, so the entries logged before the process exits are not lost.
+func (l *Logger) flush() {
+	for _, s := range l.sinks {
+		if f, ok := s.sink.(flusher); ok {
+			_ = f.Flush()
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, output := range append([]io.Writer{l.output, l.splitOutput}, l.extraOutputs...) {
+		if s, ok := output.(syncer); ok && output != os.Stdout && output != os.Stderr {
+			_ = s.Sync()
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package loggo_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+type flushRecorder struct {
+	events *[]string
+}
+
+func (r flushRecorder) WriteEntry(entry loggo.Entry) error {
+	*r.events = append(*r.events, "write "+entry.Message)
+
+	return nil
+}
+
+func (r flushRecorder) Flush() error {
+	*r.events = append(*r.events, "flush")
+
+	return nil
+}
+
+func TestWithExitFunc(t *testing.T) {
+	type testCase struct {
+		name      string
+		threshold loggo.Level
+		fatal     func(l *loggo.Logger)
+		want      []string
+	}
+
+	testCases := []testCase{
+		{
+			name:      "fatal",
+			threshold: loggo.LevelInfo,
+			fatal:     func(l *loggo.Logger) { l.Fatal("stopped") },
+			want:      []string{"write stopped", "flush", "exit 1"},
+		},
+		{
+			name:      "fatalf",
+			threshold: loggo.LevelInfo,
+			fatal:     func(l *loggo.Logger) { l.Fatalf("stopped %d", 2) },
+			want:      []string{"write stopped 2", "flush", "exit 1"},
+		},
+		{
+			name:      "fatalKV",
+			threshold: loggo.LevelInfo,
+			fatal:     func(l *loggo.Logger) { l.FatalKV("stopped", "code", 3) },
+			want:      []string{"write stopped", "flush", "exit 1"},
+		},
+		{
+			name:      "discarded",
+			threshold: loggo.Level(255),
+			fatal:     func(l *loggo.Logger) { l.Fatal("stopped") },
+			want:      []string{"flush", "exit 1"},
+		},
+		{
+			name:      "muted",
+			threshold: loggo.LevelInfo,
+			fatal: func(l *loggo.Logger) {
+				l.Mute()
+				l.Fatal("stopped")
+			},
+			want: []string{"flush", "exit 1"},
+		},
+		{
+			name:      "skipped by if",
+			threshold: loggo.LevelInfo,
+			fatal:     func(l *loggo.Logger) { l.If(false).FatalKV("stopped", "code", 3) },
+			want:      nil,
+		},
+		{
+			name:      "batch",
+			threshold: loggo.LevelInfo,
+			fatal: func(l *loggo.Logger) {
+				b := l.Batch()
+				b.Info("stopping")
+				b.Fatalf("stopped %d", 4)
+			},
+			want: []string{"write stopping", "write stopped 4", "flush", "exit 1"},
+		},
+		{
+			name:      "batch skipped by if",
+			threshold: loggo.LevelInfo,
+			fatal:     func(l *loggo.Logger) { l.If(false).Batch().Fatal("stopped") },
+			want:      nil,
+		},
+		{
+			name:      "log at fatal level",
+			threshold: loggo.LevelInfo,
+			fatal:     func(l *loggo.Logger) { l.Log(loggo.LevelFatal, "stopped") },
+			want:      []string{"write stopped"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var events []string
+			logger := loggo.New(tc.threshold, loggo.WithOutput(&strings.Builder{}),
+				loggo.WithSink(flushRecorder{events: &events}),
+				loggo.WithExitFunc(func(code int) { events = append(events, "exit "+strconv.Itoa(code)) }))
+
+			tc.fatal(logger)
+
+			if strings.Join(events, ", ") != strings.Join(tc.want, ", ") {
+				t.Errorf("events = %v, want %v", events, tc.want)
+			}
+		})
+	}
+}
+
+func TestWithExitFunc_disabled(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Message}}"),
+		loggo.WithExitFunc(nil))
+
+	logger.Fatal("first")
+	logger.Fatal("second")
+
+	if want := "first\nsecond\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
@@ -0,0 +1,359 @@
+package loggo
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseFilter compiles a filter expression into a Filter, so routing rules, such as the ones of WithSinkFilter, do not
+// require writing Go code. An expression compares a property of the entries with a value:
+//   - level, e.g. level>=warn, compared by severity;
+//   - message, caller, function, component and scope;
+//   - any other name is the key of a field, e.g. user==42, missing fields being empty.
+//
+// The operators are ==, !=, <, <=, > and >=, comparing numerically when both sides are numbers. Values are bare words
+// or double-quoted strings. Comparisons are combined with &&, || and !, and grouped with parentheses.
+//
+// Parameters:
+//   - expr: The filter expression.
+//
+// Returns:
+//   - The compiled Filter.
+//   - An error if the expression is invalid, nil otherwise.
+//
+// Example:
+//
+//	filter, err := loggo.ParseFilter(`level>=warn && component=="payments"`)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func ParseFilter(expr string) (Filter, error) {
+	tokens, err := tokenizeFilter(expr)
+	if err != nil {
+		return nil, errors.New("error parsing filter: " + err.Error())
+	}
+
+	p := &filterParser{tokens: tokens}
+
+	filter, err := p.parseOr()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected %q", p.tokens[p.pos].text)
+	}
+
+	if err != nil {
+		return nil, errors.New("error parsing filter: " + err.Error())
+	}
+
+	return filter, nil
+}
+
+// MustParseFilter is like ParseFilter, but panics if the expression is invalid. It is meant for expressions known at
+// compile time.
+//
+// Parameters:
+//   - expr: The filter expression.
+//
+// Returns:
+//   - The compiled Filter.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithFilter(loggo.MustParseFilter(`message!="healthcheck"`)))
+func MustParseFilter(expr string) Filter {
+	filter, err := ParseFilter(expr)
+	if err != nil {
+		panic(err)
+	}
+
+	return filter
+}
+
+// filterToken is a token of a filter expression.
+type filterToken struct {
+	text   string
+	quoted bool
+}
+
+// filterOperators are the operators of the filter expressions, the longest ones first.
+var filterOperators = []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "!", "(", ")"}
+
+// tokenizeFilter splits a filter expression into tokens.
+func tokenizeFilter(expr string) ([]filterToken, error) {
+	var tokens []filterToken
+
+	for i := 0; i < len(expr); {
+		c := expr[i]
+
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+
+			continue
+		case c == '"':
+			end := i + 1
+			for end < len(expr) && expr[end] != '"' {
+				if expr[end] == '\\' {
+					end++
+				}
+
+				end++
+			}
+
+			if end >= len(expr) {
+				return nil, errors.New("unterminated string")
+			}
+
+			text, err := strconv.Unquote(expr[i : end+1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid string %s", expr[i:end+1])
+			}
+
+			tokens = append(tokens, filterToken{text: text, quoted: true})
+			i = end + 1
+
+			continue
+		}
+
+		operator := ""
+		for _, op := range filterOperators {
+			if strings.HasPrefix(expr[i:], op) {
+				operator = op
+
+				break
+			}
+		}
+
+		if operator != "" {
+			tokens = append(tokens, filterToken{text: operator})
+			i += len(operator)
+
+			continue
+		}
+
+		end := i
+		for end < len(expr) && strings.IndexByte(" \t\"&|=!<>()", expr[end]) < 0 {
+			end++
+		}
+
+		if end == i {
+			return nil, fmt.Errorf("unexpected %q", expr[i])
+		}
+
+		tokens = append(tokens, filterToken{text: expr[i:end]})
+		i = end
+	}
+
+	return tokens, nil
+}
+
+// filterParser is a recursive descent parser of filter expressions.
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+// next returns the next token, without consuming it, and whether there is one.
+func (p *filterParser) next() (filterToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return filterToken{}, false
+	}
+
+	return p.tokens[p.pos], true
+}
+
+// accept consumes the next token if it is the given operator.
+func (p *filterParser) accept(operator string) bool {
+	if t, ok := p.next(); ok && !t.quoted && t.text == operator {
+		p.pos++
+
+		return true
+	}
+
+	return false
+}
+
+// parseOr parses comparisons combined with ||.
+func (p *filterParser) parseOr() (Filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		l := left
+		left = func(entry Entry) bool { return l(entry) || right(entry) }
+	}
+
+	return left, nil
+}
+
+// parseAnd parses comparisons combined with &&.
+func (p *filterParser) parseAnd() (Filter, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept("&&") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		l := left
+		left = func(entry Entry) bool { return l(entry) && right(entry) }
+	}
+
+	return left, nil
+}
+
+// parseUnary parses a negation, a parenthesized expression, or a comparison.
+func (p *filterParser) parseUnary() (Filter, error) {
+	if p.accept("!") {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return func(entry Entry) bool { return !operand(entry) }, nil
+	}
+
+	if p.accept("(") {
+		filter, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if !p.accept(")") {
+			return nil, errors.New("missing )")
+		}
+
+		return filter, nil
+	}
+
+	return p.parseComparison()
+}
+
+// parseComparison parses a comparison of a property of the entries with a value.
+func (p *filterParser) parseComparison() (Filter, error) {
+	name, ok := p.next()
+	if !ok {
+		return nil, errors.New("unexpected end of expression")
+	}
+
+	if !name.quoted && strings.IndexByte("&|=!<>()", name.text[0]) >= 0 {
+		return nil, fmt.Errorf("unexpected %q", name.text)
+	}
+
+	p.pos++
+
+	operator, ok := p.next()
+	if !ok || operator.quoted || !isComparison(operator.text) {
+		return nil, fmt.Errorf("missing comparison operator after %q", name.text)
+	}
+
+	p.pos++
+
+	value, ok := p.next()
+	if !ok || (!value.quoted && strings.IndexByte("&|=!<>()", value.text[0]) >= 0) {
+		return nil, fmt.Errorf("missing value after %s%s", name.text, operator.text)
+	}
+
+	p.pos++
+
+	if name.text == "level" {
+		level, ok := levelByName(value.text)
+		if !ok {
+			return nil, fmt.Errorf("unknown level %q", value.text)
+		}
+
+		return func(entry Entry) bool {
+			return compare(operator.text, entry.Level.Rank()-level.Rank())
+		}, nil
+	}
+
+	property := entryProperty(name.text)
+
+	return func(entry Entry) bool {
+		return compare(operator.text, compareValues(property(entry), value.text))
+	}, nil
+}
+
+// isComparison reports whether the operator is a comparison operator.
+func isComparison(operator string) bool {
+	switch operator {
+	case "==", "!=", "<", "<=", ">", ">=":
+		return true
+	default:
+		return false
+	}
+}
+
+// entryProperty returns the function returning the named property of the entries, as a string.
+func entryProperty(name string) func(entry Entry) string {
+	switch name {
+	case "message":
+		return func(entry Entry) string { return entry.Message }
+	case "caller":
+		return func(entry Entry) string { return entry.Caller }
+	case "function":
+		return func(entry Entry) string { return entry.Function }
+	case "component":
+		return func(entry Entry) string { return entry.Component }
+	case "scope":
+		return func(entry Entry) string { return entry.Scope }
+	default:
+		return func(entry Entry) string {
+			if value, ok := entry.Fields.Get(name); ok {
+				return fmt.Sprint(value)
+			}
+
+			return ""
+		}
+	}
+}
+
+// compareValues compares two values, numerically if both are numbers, returning a negative number, zero or a
+// positive number if a is less than, equal to or greater than b.
+func compareValues(a, b string) int {
+	x, errA := strconv.ParseFloat(a, 64)
+	y, errB := strconv.ParseFloat(b, 64)
+
+	if errA != nil || errB != nil {
+		return strings.Compare(a, b)
+	}
+
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compare applies the comparison operator to the result of a comparison.
+func compare(operator string, cmp int) bool {
+	switch operator {
+	case "==":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	default:
+		return cmp >= 0
+	}
+}
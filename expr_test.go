@@ -0,0 +1,95 @@
+package loggo_test
+
+import (
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+func TestParseFilter(t *testing.T) {
+	entry := loggo.Entry{
+		Level:     loggo.LevelWarn,
+		Message:   "charge failed",
+		Component: "payments",
+		Fields:    loggo.Fields{loggo.F("attempt", 3), loggo.F("user", "ana maria")},
+	}
+
+	type testCase struct {
+		expr string
+		want bool
+	}
+
+	testCases := []testCase{
+		{expr: "level>=warn", want: true},
+		{expr: "level>=ERROR", want: false},
+		{expr: "level==warn", want: true},
+		{expr: "level<warn", want: false},
+		{expr: `component=="payments"`, want: true},
+		{expr: "component!=payments", want: false},
+		{expr: `level>=warn && component=="payments"`, want: true},
+		{expr: `level>=error || message=="charge failed"`, want: true},
+		{expr: "!(level>=warn)", want: false},
+		{expr: "attempt>2", want: true},
+		{expr: "attempt>10", want: false},
+		{expr: `user=="ana maria"`, want: true},
+		{expr: `missing==""`, want: true},
+		{expr: "level>=debug && (attempt<3 || component==payments)", want: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expr, func(t *testing.T) {
+			filter, err := loggo.ParseFilter(tc.expr)
+			if err != nil {
+				t.Fatalf("ParseFilter() error = %v", err)
+			}
+
+			if got := filter(entry); got != tc.want {
+				t.Errorf("filter(entry) = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseFilter_errors(t *testing.T) {
+	type testCase struct {
+		expr string
+		want string
+	}
+
+	testCases := []testCase{
+		{expr: "", want: "error parsing filter: unexpected end of expression"},
+		{expr: "level>=loud", want: `error parsing filter: unknown level "loud"`},
+		{expr: "level", want: `error parsing filter: missing comparison operator after "level"`},
+		{expr: "level>=", want: "error parsing filter: missing value after level>="},
+		{expr: `message=="open`, want: "error parsing filter: unterminated string"},
+		{expr: "(level>=warn", want: "error parsing filter: missing )"},
+		{expr: "level>=warn)", want: `error parsing filter: unexpected ")"`},
+		{expr: "level>=warn &&", want: "error parsing filter: unexpected end of expression"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expr, func(t *testing.T) {
+			if _, err := loggo.ParseFilter(tc.expr); err == nil || err.Error() != tc.want {
+				t.Errorf("ParseFilter() error = %v, want %q", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestWithFilteredSink(t *testing.T) {
+	all, errors := loggotest.NewObserver(), loggotest.NewObserver()
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(&loggotest.Recorder{}), loggo.WithSink(all),
+		loggo.WithFilteredSink(errors, loggo.MustParseFilter("level>=error")))
+
+	logger.Info("info")
+	logger.Error("error")
+
+	if got := len(all.Entries()); got != 2 {
+		t.Errorf("entries of the unfiltered sink = %d, want 2", got)
+	}
+
+	if got := errors.Entries(); len(got) != 1 || got[0].Message != "error" {
+		t.Errorf("entries of the filtered sink = %v, want the error entry", got)
+	}
+}
@@ -0,0 +1,41 @@
+package loggo
+
+import (
+	"expvar"
+)
+
+// WithExpvar publishes the metrics of a Logger under the given name with the expvar package, so they are served on
+// /debug/vars with no extra code. The metrics are read from the Health of the Logger when the variable is read:
+//
+//	{"entries": {"INFO": 42, "WARN": 1}, "errors": 0, "dropped": 0, "queue_depth": 0}
+//
+// As with expvar.Publish, the option panics if the name is already in use.
+//
+// Parameters:
+//   - name: The name of the expvar variable.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithExpvar("logger"))
+func WithExpvar(name string) Option {
+	return func(l *Logger) {
+		expvar.Publish(name, expvar.Func(func() any {
+			return l.Health().expvar()
+		}))
+	}
+}
+
+// expvar returns the metrics of the health, as published by WithExpvar.
+func (h Health) expvar() map[string]any {
+	entries := make(map[string]uint64, len(h.Entries))
+	for level, n := range h.Entries {
+		entries[level.String()] = n
+	}
+
+	return map[string]any{
+		"entries":     entries,
+		"errors":      h.Errors,
+		"dropped":     h.Dropped,
+		"queue_depth": h.QueueDepth,
+	}
+}
@@ -0,0 +1,27 @@
+package loggo_test
+
+import (
+	"expvar"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+func TestWithExpvar(t *testing.T) {
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(&loggotest.Recorder{}), loggo.WithExpvar("loggo_test"))
+	logger.Info("first")
+	logger.Info("second")
+	logger.Warn("third")
+	logger.Debug("discarded")
+
+	v := expvar.Get("loggo_test")
+	if v == nil {
+		t.Fatal("expvar.Get() = nil, want the published metrics")
+	}
+
+	want := `{"dropped":0,"entries":{"INFO":2,"WARN":1},"errors":0,"queue_depth":0}`
+	if got := v.String(); got != want {
+		t.Errorf("expvar = %s, want %s", got, want)
+	}
+}
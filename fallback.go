@@ -0,0 +1,40 @@
+package loggo
+
+import (
+	"bytes"
+	"time"
+)
+
+// writeFallback writes the entries at LevelError and above to the fallback output of the logger, if any, in a plain
+// format independent of its template: "<RFC 3339 time> <LEVEL> <message> <fields>". Its failures are ignored, as it is
+// the last resort. The lock must be held.
+func (l *Logger) writeFallback(entries ...Entry) {
+	if l.fallback == nil {
+		return
+	}
+
+	var buf bytes.Buffer
+
+	for _, entry := range entries {
+		if entry.Level.Rank() < LevelError.Rank() {
+			continue
+		}
+
+		buf.WriteString(entry.Time.Format(time.RFC3339))
+		buf.WriteByte(' ')
+		buf.WriteString(entry.Level.String())
+		buf.WriteByte(' ')
+		buf.WriteString(entry.Message)
+
+		if len(entry.Fields) > 0 {
+			buf.WriteByte(' ')
+			buf.WriteString(entry.Fields.String())
+		}
+
+		buf.WriteByte('\n')
+	}
+
+	if buf.Len() > 0 {
+		_, _ = l.fallback.Write(buf.Bytes())
+	}
+}
@@ -0,0 +1,67 @@
+package loggo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+func TestWithFallback(t *testing.T) {
+	type testCase struct {
+		name    string
+		options []loggo.Option
+		want    string
+	}
+
+	testCases := []testCase{
+		{
+			name:    "failing output",
+			options: []loggo.Option{loggo.WithOutput(errorWriter{})},
+			want:    "2022-01-25T00:00:00Z ERROR database unreachable user=42\n",
+		},
+		{
+			name: "failing sinks",
+			options: []loggo.Option{
+				loggo.WithOutput(&loggotest.Recorder{}),
+				loggo.WithSink(&failAfterSink{limit: 0}),
+				loggo.WithSink(&failAfterSink{limit: 0}),
+				loggo.WithDeadLetter(&strings.Builder{}),
+			},
+			want: "2022-01-25T00:00:00Z ERROR database unreachable user=42\n",
+		},
+		{
+			name: "one sink receiving",
+			options: []loggo.Option{
+				loggo.WithOutput(&loggotest.Recorder{}),
+				loggo.WithSink(&failAfterSink{limit: 0}),
+				loggo.WithSink(loggotest.NewObserver()),
+				loggo.WithDeadLetter(&strings.Builder{}),
+			},
+			want: "",
+		},
+		{
+			name:    "healthy output",
+			options: []loggo.Option{loggo.WithOutput(&loggotest.Recorder{})},
+			want:    "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fallback := &strings.Builder{}
+			options := append([]loggo.Option{loggo.WithTimeProvider(fakeNow), loggo.WithFallback(fallback)}, tc.options...)
+			logger := loggo.New(loggo.LevelInfo, options...)
+
+			release := loggo.PushFields(loggo.F("user", 42))
+			logger.Warn("slow query")
+			logger.Error("database unreachable")
+			release()
+
+			if fallback.String() != tc.want {
+				t.Errorf("fallback = %q, want %q", fallback.String(), tc.want)
+			}
+		})
+	}
+}
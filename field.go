@@ -0,0 +1,161 @@
+package loggo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Field is a key-value pair attached to a log entry.
+type Field struct {
+	Key   string `json:"key"`   // Key of the field
+	Value any    `json:"value"` // Value of the field
+}
+
+// F returns a Field with the given key and value.
+//
+// Parameters:
+//   - key: The key of the field.
+//   - value: The value of the field.
+//
+// Returns:
+//   - The Field.
+//
+// Example:
+//
+//	release := loggo.PushFields(loggo.F("request_id", id))
+//	defer release()
+func F(key string, value any) Field {
+	return Field{Key: key, Value: value}
+}
+
+// Fields is a list of fields attached to a log entry, in the order they were added.
+type Fields []Field
+
+// String returns the fields in logfmt, e.g. `user=42 path="/a b"`. Values containing spaces, quotes, equal signs or
+// control characters are quoted.
+func (f Fields) String() string {
+	var b strings.Builder
+
+	for i, field := range f {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+
+		b.WriteString(field.Key)
+		b.WriteByte('=')
+		b.WriteString(quoteValue(fmt.Sprint(field.Value)))
+	}
+
+	return b.String()
+}
+
+// FieldsFunc is a function returning dynamic fields, such as the current memory usage, see WithFieldsFromFunc.
+type FieldsFunc func() Fields
+
+// ContextFields is a function returning fields from the context of a Logger, such as the members of the OpenTelemetry
+// baggage, see WithContextFields.
+type ContextFields func(ctx context.Context) Fields
+
+// missingValue is the value of a key without a value, see fieldsOf.
+const missingValue = "!MISSING"
+
+// fieldsOf returns the fields of alternating keys and values, e.g. "user", 42. The Field values are taken as is, the
+// keys that are not strings are formatted with fmt.Sprint, and a key without a value is given missingValue.
+func fieldsOf(keyvals []any) Fields {
+	fields := make(Fields, 0, len(keyvals)/2+1)
+
+	for i := 0; i < len(keyvals); i++ {
+		if f, ok := keyvals[i].(Field); ok {
+			fields = append(fields, f)
+			continue
+		}
+
+		key, ok := keyvals[i].(string)
+		if !ok {
+			key = fmt.Sprint(keyvals[i])
+		}
+
+		if i+1 == len(keyvals) {
+			fields = append(fields, F(key, missingValue))
+			break
+		}
+
+		fields = append(fields, F(key, keyvals[i+1]))
+		i++
+	}
+
+	return fields
+}
+
+// Get returns the value of the last field with the given key, and whether it was found.
+func (f Fields) Get(key string) (any, bool) {
+	for i := len(f) - 1; i >= 0; i-- {
+		if f[i].Key == key {
+			return f[i].Value, true
+		}
+	}
+
+	return nil, false
+}
+
+// fieldTruncated is the marker appended to the values of the fields truncated to the maximum field size.
+const fieldTruncated = "...(truncated)"
+
+// limitFields returns the fields limited to the maximum number of fields of the logger, followed by a "fields_dropped"
+// field with the number of fields dropped, and with their values truncated to its maximum field size, rendered with
+// fmt.Sprint, without splitting a UTF-8 character, followed by a truncation marker. The given fields are left
+// unchanged.
+func (l *Logger) limitFields(fields Fields) Fields {
+	dropped := 0
+	if l.maxFields > 0 && len(fields) > l.maxFields {
+		dropped = len(fields) - l.maxFields
+		fields = fields[:l.maxFields:l.maxFields]
+	}
+
+	if l.maxFieldSize > 0 {
+		var limited Fields
+
+		for i, field := range fields {
+			if s := fmt.Sprint(field.Value); len(s) > l.maxFieldSize {
+				if limited == nil {
+					limited = append(Fields{}, fields...)
+				}
+
+				end := l.maxFieldSize
+				for end > 0 && !utf8.RuneStart(s[end]) {
+					end--
+				}
+
+				limited[i].Value = s[:end] + fieldTruncated
+			}
+		}
+
+		if limited != nil {
+			fields = limited
+		}
+	}
+
+	if dropped > 0 {
+		fields = append(fields, F("fields_dropped", dropped))
+	}
+
+	return fields
+}
+
+// quoteValue quotes a logfmt value if needed.
+func quoteValue(s string) string {
+	if s == "" {
+		return `""`
+	}
+
+	for _, r := range s {
+		if r <= ' ' || r == '"' || r == '=' || r == 0x7f {
+			return strconv.Quote(s)
+		}
+	}
+
+	return s
+}
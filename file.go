@@ -0,0 +1,136 @@
+package loggo
+
+import (
+	"os"
+	"sync"
+)
+
+// File is a log output writing to a file, to be used with WithOutput. It is safe for concurrent use.
+type File struct {
+	mu      sync.Mutex
+	file    *os.File
+	charset Charset
+	bom     bool
+	buf     []byte
+	layout  string
+}
+
+// FileOption is a function that configures a File.
+type FileOption func(*File)
+
+// OpenFile opens a File for appending log lines, creating it if it does not exist.
+//
+// Parameters:
+//   - path: The path of the file.
+//   - options: Variadic options to configure the File.
+//
+// Returns:
+//   - A pointer to the opened File.
+//   - An error if the file could not be opened, nil otherwise.
+//
+// Example:
+//
+//	file, err := loggo.OpenFile("app.log", loggo.WithBOM())
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer file.Close()
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(file))
+func OpenFile(path string, options ...FileOption) (*File, error) {
+	f := &File{charset: CharsetUTF8}
+
+	for _, option := range options {
+		option(f)
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	f.file = file
+
+	if f.bom {
+		if err = f.writeBOM(); err != nil {
+			_ = file.Close()
+
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
+
+// WithBOM configures a File to write the byte order mark of its charset at the start of the file, when the file is
+// empty. It helps downstream Windows tooling that misdetects plain UTF-8.
+//
+// Example:
+//
+//	file, err := loggo.OpenFile("app.log", loggo.WithBOM())
+func WithBOM() FileOption {
+	return func(f *File) {
+		f.bom = true
+	}
+}
+
+// WithCharset configures the charset a File transcodes the log lines to. The default charset is CharsetUTF8.
+//
+// Parameters:
+//   - charset: The Charset to use.
+//
+// Example:
+//
+//	file, err := loggo.OpenFile("app.log", loggo.WithCharset(loggo.CharsetUTF16LE), loggo.WithBOM())
+func WithCharset(charset Charset) FileOption {
+	return func(f *File) {
+		f.charset = charset
+	}
+}
+
+// Write writes the UTF-8 text in p to the file, transcoded to the charset of the File.
+// It returns the number of bytes of p written.
+func (f *File) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.buf = f.charset.Encode(f.buf[:0], p)
+
+	if _, err := f.file.Write(f.buf); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Sync commits the contents of the file to stable storage.
+func (f *File) Sync() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.file.Sync()
+}
+
+// Close closes the file.
+func (f *File) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.file.Close()
+}
+
+// writeBOM writes the byte order mark of the charset, if the file is empty.
+func (f *File) writeBOM() error {
+	info, err := f.file.Stat()
+	if err != nil {
+		return err
+	}
+
+	if info.Size() > 0 {
+		return nil
+	}
+
+	_, err = f.file.Write(f.charset.BOM())
+
+	return err
+}
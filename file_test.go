@@ -0,0 +1,97 @@
+package loggo_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestOpenFile(t *testing.T) {
+	type testCase struct {
+		name    string
+		options []loggo.FileOption
+		want    []byte
+	}
+
+	testCases := []testCase{
+		{
+			name: "utf-8",
+			want: []byte("olá\nolá\n"),
+		},
+		{
+			name:    "utf-8 with bom",
+			options: []loggo.FileOption{loggo.WithBOM()},
+			want:    []byte("\xEF\xBB\xBFolá\nolá\n"),
+		},
+		{
+			name:    "utf-16le with bom",
+			options: []loggo.FileOption{loggo.WithCharset(loggo.CharsetUTF16LE), loggo.WithBOM()},
+			want:    []byte("\xFF\xFEo\x00l\x00\xE1\x00\n\x00o\x00l\x00\xE1\x00\n\x00"),
+		},
+		{
+			name:    "latin-1",
+			options: []loggo.FileOption{loggo.WithCharset(loggo.CharsetLatin1), loggo.WithBOM()},
+			want:    []byte("ol\xE1\nol\xE1\n"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "app.log")
+
+			// The file is opened twice, so the byte order mark must only be written once.
+			for i := 0; i < 2; i++ {
+				file, err := loggo.OpenFile(path, tc.options...)
+				if err != nil {
+					t.Fatalf("OpenFile() error = %v", err)
+				}
+
+				logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(file), loggo.WithTemplate("{{.Message}}"))
+				logger.Info("olá")
+
+				if err = file.Sync(); err != nil {
+					t.Errorf("File.Sync() error = %v", err)
+				}
+
+				if err = file.Close(); err != nil {
+					t.Errorf("File.Close() error = %v", err)
+				}
+			}
+
+			got, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(got, tc.want) {
+				t.Errorf("OpenFile() content = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestOpenFile_errors(t *testing.T) {
+	if _, err := loggo.OpenFile(filepath.Join(t.TempDir(), "missing", "app.log")); err == nil {
+		t.Error("OpenFile() error = nil, want an error")
+	}
+
+	file, err := loggo.OpenFile(filepath.Join(t.TempDir(), "app.log"))
+	if err != nil {
+		t.Fatalf("OpenFile() error = %v", err)
+	}
+
+	_ = file.Close()
+
+	if _, err = file.Write([]byte("closed")); err == nil {
+		t.Error("File.Write() error = nil, want an error")
+	}
+}
+
+func TestCharsetLatin1_unrepresentable(t *testing.T) {
+	if got := loggo.CharsetLatin1.Encode(nil, []byte("a→b")); string(got) != "a?b" {
+		t.Errorf("CharsetLatin1.Encode() = %q, want %q", got, "a?b")
+	}
+}
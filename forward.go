@@ -0,0 +1,214 @@
+package loggo
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"net"
+	"sync"
+)
+
+// maxForwardedEntry is the maximum size, in bytes, of a JSON-encoded entry received by a Receiver.
+const maxForwardedEntry = 1 << 20
+
+// NetSink is a Sink forwarding the entries over a stream connection, such as TCP or a Unix socket, to a Receiver, as
+// newline-delimited JSON entries. The connection is dialed on the first entry, and dialed again on the entry following
+// a failure, which is returned, so it can be wrapped in a Spool. It is safe for concurrent use.
+type NetSink struct {
+	mu      sync.Mutex
+	network string
+	address string
+	conn    net.Conn
+}
+
+// NewNetSink returns a NetSink forwarding the entries to the address on the network, as accepted by net.Dial.
+//
+// Parameters:
+//   - network: The network of the Receiver, e.g. "tcp" or "unix".
+//   - address: The address of the Receiver, e.g. "aggregator:5170" or "/run/loggo.sock".
+//
+// Returns:
+//   - A pointer to the NetSink.
+//
+// Example:
+//
+//	sink := loggo.NewNetSink("unix", "/run/loggo.sock")
+//	defer sink.Close()
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithSink(sink))
+func NewNetSink(network, address string) *NetSink {
+	return &NetSink{network: network, address: address}
+}
+
+// WriteEntry forwards the entry to the Receiver, dialing it if needed.
+//
+// Parameters:
+//   - entry: The entry to forward.
+//
+// Returns:
+//   - An error if the entry could not be forwarded, nil otherwise.
+func (s *NetSink) WriteEntry(entry Entry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return errors.New("error encoding entry: " + err.Error())
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		if s.conn, err = net.Dial(s.network, s.address); err != nil {
+			return errors.New("error dialing receiver: " + err.Error())
+		}
+	}
+
+	if _, err = s.conn.Write(append(line, '\n')); err != nil {
+		_ = s.conn.Close()
+		s.conn = nil
+
+		return errors.New("error forwarding entry: " + err.Error())
+	}
+
+	return nil
+}
+
+// Close closes the connection to the Receiver, if any.
+//
+// Returns:
+//   - An error if the connection could not be closed, nil otherwise.
+func (s *NetSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+
+	err := s.conn.Close()
+	s.conn = nil
+
+	return err
+}
+
+// Receiver is a server accepting the entries forwarded by NetSinks, and logging them to a Logger, preserving their
+// original level, time and caller, as Replay does. It allows building sidecar and aggregator processes with loggo.
+type Receiver struct {
+	listener net.Listener
+	logger   *Logger
+	mu       sync.Mutex
+	conns    map[net.Conn]struct{}
+	closed   bool
+	wg       sync.WaitGroup
+}
+
+// NewReceiver returns a Receiver accepting the connections of the listener, and logging the entries received to the
+// Logger. Call Serve to start accepting connections.
+//
+// Parameters:
+//   - listener: The listener accepting the connections of the NetSinks.
+//   - logger: The Logger receiving the entries.
+//
+// Returns:
+//   - A pointer to the Receiver.
+//
+// Example:
+//
+//	listener, err := net.Listen("unix", "/run/loggo.sock")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	receiver := loggo.NewReceiver(listener, loggo.New(loggo.LevelInfo, loggo.WithOutput(file)))
+//	log.Fatal(receiver.Serve())
+func NewReceiver(listener net.Listener, logger *Logger) *Receiver {
+	return &Receiver{listener: listener, logger: logger, conns: map[net.Conn]struct{}{}}
+}
+
+// Addr returns the address the Receiver listens on.
+//
+// Returns:
+//   - The address of the listener.
+func (r *Receiver) Addr() net.Addr {
+	return r.listener.Addr()
+}
+
+// Serve accepts connections until the Receiver is closed, logging the entries received on each of them. Invalid
+// lines are skipped.
+//
+// Returns:
+//   - nil once the Receiver is closed, or the error that stopped accepting connections.
+func (r *Receiver) Serve() error {
+	for {
+		conn, err := r.listener.Accept()
+		if err != nil {
+			r.mu.Lock()
+			closed := r.closed
+			r.mu.Unlock()
+
+			if closed {
+				return nil
+			}
+
+			return errors.New("error accepting connection: " + err.Error())
+		}
+
+		r.mu.Lock()
+		if r.closed {
+			r.mu.Unlock()
+			_ = conn.Close()
+
+			return nil
+		}
+
+		r.conns[conn] = struct{}{}
+		r.wg.Add(1)
+		r.mu.Unlock()
+
+		go r.receive(conn)
+	}
+}
+
+// receive logs the entries received on the connection until it is closed.
+func (r *Receiver) receive(conn net.Conn) {
+	defer r.wg.Done()
+
+	defer func() {
+		r.mu.Lock()
+		delete(r.conns, conn)
+		r.mu.Unlock()
+
+		_ = conn.Close()
+	}()
+
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxForwardedEntry)
+
+	for scanner.Scan() {
+		var entry Entry
+		if json.Unmarshal(scanner.Bytes(), &entry) != nil {
+			continue
+		}
+
+		_ = r.logger.replay(entry)
+	}
+}
+
+// Close stops accepting connections, closes the open ones, and waits for the entries already received to be logged.
+//
+// Returns:
+//   - An error if the listener could not be closed, nil otherwise.
+func (r *Receiver) Close() error {
+	r.mu.Lock()
+	r.closed = true
+	err := r.listener.Close()
+
+	for conn := range r.conns {
+		_ = conn.Close()
+	}
+
+	r.mu.Unlock()
+
+	r.wg.Wait()
+
+	return err
+}
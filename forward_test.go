@@ -0,0 +1,58 @@
+package loggo_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+func TestReceiver(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error = %v", err)
+	}
+
+	recorder := &loggotest.Recorder{}
+	receiver := loggo.NewReceiver(listener, loggo.New(loggo.LevelInfo, loggo.WithOutput(recorder),
+		loggo.WithTemplate("{{.Time}} {{.Level}} {{.Message}} {{.Caller}} {{.Fields}}")))
+
+	served := make(chan error, 1)
+	go func() { served <- receiver.Serve() }()
+
+	sink := loggo.NewNetSink("tcp", receiver.Addr().String())
+	defer sink.Close()
+
+	logger, _ := loggotest.New(loggo.LevelDebug, loggo.WithOutput(&loggotest.Recorder{}), loggo.WithSink(sink),
+		loggo.WithCallerProvider(func() (uintptr, string, int, bool) { return 0, "app.go", 7, true }))
+
+	release := loggo.PushFields(loggo.F("user", "ana"))
+	logger.Debug("below the receiver threshold")
+	logger.Warn("forwarded")
+	release()
+
+	waitLogged(recorder, "forwarded")
+
+	if err := receiver.Close(); err != nil {
+		t.Errorf("Receiver.Close() error = %v", err)
+	}
+
+	if err := <-served; err != nil {
+		t.Errorf("Receiver.Serve() error = %v", err)
+	}
+
+	want := []string{"2022-01-25 00:00:00 WARN forwarded app.go:7 user=ana"}
+	if got := recorder.All(); len(got) != 1 || got[0] != want[0] {
+		t.Errorf("received = %q, want %q", got, want)
+	}
+}
+
+func TestNetSink_redial(t *testing.T) {
+	sink := loggo.NewNetSink("tcp", "127.0.0.1:1")
+
+	if err := sink.WriteEntry(loggo.Entry{Time: time.Now()}); err == nil {
+		t.Error("NetSink.WriteEntry() error = nil, want a dial error")
+	}
+}
@@ -0,0 +1,218 @@
+package loggo
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"sync"
+	"time"
+)
+
+// DefaultFlushInterval is the default interval at which a GzipWriter flushes the compressed stream.
+const DefaultFlushInterval = 5 * time.Second
+
+// GzipWriter is a log output compressing the log lines to a gzip stream, to be used with WithOutput. It is safe for
+// concurrent use.
+//
+// The compressed stream is sync-flushed at an interval and on Close, so long-running jobs can write compressed logs
+// directly, and readers of the stream see every line written up to the last flush.
+type GzipWriter struct {
+	mu       sync.Mutex
+	dst      io.Writer
+	gz       *gzip.Writer
+	level    int
+	interval time.Duration
+	clock    Clock
+	ticker   Ticker
+	done     chan struct{}
+	stopped  chan struct{}
+	pending  bool
+	closed   bool
+}
+
+// GzipOption is a function that configures a GzipWriter.
+type GzipOption func(*GzipWriter)
+
+// NewGzipWriter returns a GzipWriter compressing the log lines to the destination writer.
+// If the destination is an io.Closer, it is closed when the GzipWriter is closed.
+//
+// Parameters:
+//   - dst: The writer of the compressed stream.
+//   - options: Variadic options to configure the GzipWriter.
+//
+// Returns:
+//   - A pointer to the GzipWriter.
+//   - An error if the compression level is invalid, nil otherwise.
+//
+// Example:
+//
+//	file, err := os.Create("app.log.gz")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	gz, err := loggo.NewGzipWriter(file, loggo.WithFlushInterval(time.Second))
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer gz.Close()
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(gz))
+func NewGzipWriter(dst io.Writer, options ...GzipOption) (*GzipWriter, error) {
+	g := &GzipWriter{
+		dst:      dst,
+		level:    gzip.DefaultCompression,
+		interval: DefaultFlushInterval,
+		clock:    systemClock{now: time.Now},
+	}
+
+	for _, option := range options {
+		option(g)
+	}
+
+	gz, err := gzip.NewWriterLevel(dst, g.level)
+	if err != nil {
+		return nil, err
+	}
+
+	g.gz = gz
+
+	if g.interval > 0 {
+		g.ticker = g.clock.NewTicker(g.interval)
+		g.done = make(chan struct{})
+		g.stopped = make(chan struct{})
+
+		go g.flushLoop()
+	}
+
+	return g, nil
+}
+
+// WithCompressionLevel configures the compression level of a GzipWriter, from gzip.BestSpeed to
+// gzip.BestCompression. The default level is gzip.DefaultCompression.
+//
+// Parameters:
+//   - level: The compression level.
+//
+// Example:
+//
+//	gz, err := loggo.NewGzipWriter(file, loggo.WithCompressionLevel(gzip.BestSpeed))
+func WithCompressionLevel(level int) GzipOption {
+	return func(g *GzipWriter) {
+		g.level = level
+	}
+}
+
+// WithFlushInterval configures the interval at which a GzipWriter flushes the compressed stream, when lines were
+// written since the last flush. The default interval is DefaultFlushInterval. A non-positive interval disables the
+// periodic flush, so the stream is only flushed by Flush and Close.
+//
+// Parameters:
+//   - interval: The flush interval.
+//
+// Example:
+//
+//	gz, err := loggo.NewGzipWriter(file, loggo.WithFlushInterval(time.Second))
+func WithFlushInterval(interval time.Duration) GzipOption {
+	return func(g *GzipWriter) {
+		g.interval = interval
+	}
+}
+
+// WithFlushClock configures the Clock driving the periodic flush of a GzipWriter, so it can be faked in tests.
+//
+// Parameters:
+//   - clock: The Clock to use.
+//
+// Example:
+//
+//	clock := loggotest.NewClock()
+//	gz, err := loggo.NewGzipWriter(&buf, loggo.WithFlushClock(clock))
+//	clock.Advance(loggo.DefaultFlushInterval)
+func WithFlushClock(clock Clock) GzipOption {
+	return func(g *GzipWriter) {
+		g.clock = clock
+	}
+}
+
+// Write compresses p to the stream. The compressed data may be buffered until the next flush.
+// It returns the number of bytes of p written.
+func (g *GzipWriter) Write(p []byte) (int, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.closed {
+		return 0, errors.New("gzip writer closed")
+	}
+
+	g.pending = true
+
+	return g.gz.Write(p)
+}
+
+// Flush sync-flushes the compressed stream to the destination writer, so every line written so far can be read.
+func (g *GzipWriter) Flush() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.flush()
+}
+
+// Close stops the periodic flush, flushes and terminates the compressed stream, and closes the destination writer if
+// it is an io.Closer.
+func (g *GzipWriter) Close() error {
+	g.mu.Lock()
+
+	if g.closed {
+		g.mu.Unlock()
+
+		return nil
+	}
+
+	g.closed = true
+	g.mu.Unlock()
+
+	if g.ticker != nil {
+		g.ticker.Stop()
+		close(g.done)
+		<-g.stopped
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err := g.gz.Close(); err != nil {
+		return err
+	}
+
+	if closer, ok := g.dst.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
+
+// flush sync-flushes the compressed stream, if lines were written since the last flush. The lock must be held.
+func (g *GzipWriter) flush() error {
+	if !g.pending || g.closed {
+		return nil
+	}
+
+	g.pending = false
+
+	return g.gz.Flush()
+}
+
+// flushLoop flushes the compressed stream at every tick, until the GzipWriter is closed.
+func (g *GzipWriter) flushLoop() {
+	defer close(g.stopped)
+
+	for {
+		select {
+		case <-g.ticker.C():
+			_ = g.Flush()
+		case <-g.done:
+			return
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package loggo_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+// syncBuffer is a bytes.Buffer safe for concurrent use, recording whether it was closed.
+type syncBuffer struct {
+	mu     sync.Mutex
+	buf    bytes.Buffer
+	closed bool
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+
+	return nil
+}
+
+// decompressed returns the lines readable from the compressed stream written so far.
+func (b *syncBuffer) decompressed() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	r, err := gzip.NewReader(bytes.NewReader(b.buf.Bytes()))
+	if err != nil {
+		return ""
+	}
+
+	out, _ := io.ReadAll(r)
+
+	return string(out)
+}
+
+func TestGzipWriter(t *testing.T) {
+	dst := &syncBuffer{}
+	clock := loggotest.NewClock()
+
+	gz, err := loggo.NewGzipWriter(dst, loggo.WithFlushClock(clock), loggo.WithFlushInterval(time.Second), loggo.WithCompressionLevel(gzip.BestSpeed))
+	if err != nil {
+		t.Fatalf("NewGzipWriter() error = %v", err)
+	}
+
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(gz), loggo.WithTemplate("{{.Message}}"))
+	logger.Info("first")
+
+	if got := dst.decompressed(); got != "" {
+		t.Errorf("decompressed before flush = %q, want empty", got)
+	}
+
+	clock.Advance(time.Second)
+
+	deadline := time.Now().Add(time.Second)
+	for dst.decompressed() != "first\n" && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	if got := dst.decompressed(); got != "first\n" {
+		t.Errorf("decompressed after interval = %q, want %q", got, "first\n")
+	}
+
+	logger.Info("second")
+
+	if err = gz.Flush(); err != nil {
+		t.Errorf("GzipWriter.Flush() error = %v", err)
+	}
+
+	if got := dst.decompressed(); got != "first\nsecond\n" {
+		t.Errorf("decompressed after Flush = %q, want %q", got, "first\nsecond\n")
+	}
+
+	logger.Info("third")
+
+	if err = gz.Close(); err != nil {
+		t.Errorf("GzipWriter.Close() error = %v", err)
+	}
+
+	if got := dst.decompressed(); got != "first\nsecond\nthird\n" {
+		t.Errorf("decompressed after Close = %q, want %q", got, "first\nsecond\nthird\n")
+	}
+
+	if !dst.closed {
+		t.Error("GzipWriter.Close() did not close the destination")
+	}
+
+	if err = gz.Close(); err != nil {
+		t.Errorf("second GzipWriter.Close() error = %v", err)
+	}
+
+	if _, err = gz.Write([]byte("late\n")); err == nil {
+		t.Error("GzipWriter.Write() after Close error = nil, want error")
+	}
+}
+
+func TestGzipWriter_noInterval(t *testing.T) {
+	var dst bytes.Buffer
+
+	gz, err := loggo.NewGzipWriter(&dst, loggo.WithFlushInterval(0))
+	if err != nil {
+		t.Fatalf("NewGzipWriter() error = %v", err)
+	}
+
+	if _, err = gz.Write([]byte("line\n")); err != nil {
+		t.Errorf("GzipWriter.Write() error = %v", err)
+	}
+
+	if err = gz.Close(); err != nil {
+		t.Errorf("GzipWriter.Close() error = %v", err)
+	}
+
+	r, err := gzip.NewReader(&dst)
+	if err != nil {
+		t.Fatalf("gzip.NewReader() error = %v", err)
+	}
+
+	if out, _ := io.ReadAll(r); string(out) != "line\n" {
+		t.Errorf("decompressed = %q, want %q", out, "line\n")
+	}
+}
+
+func TestNewGzipWriter_invalidLevel(t *testing.T) {
+	_, err := loggo.NewGzipWriter(io.Discard, loggo.WithCompressionLevel(42))
+	if err == nil {
+		t.Error("NewGzipWriter() error = nil, want error")
+	}
+}
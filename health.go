@@ -0,0 +1,144 @@
+package loggo
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Health is a snapshot of the state of the logging pipeline of a Logger, as returned by Logger.Health, so a readiness
+// probe can flag a broken pipeline.
+type Health struct {
+	LastWrite     time.Time               // Time of the last successful write to the output, zero if none
+	LastError     error                   // Last error writing to the output or a sink, nil if none
+	LastErrorTime time.Time               // Time of the last error, zero if none
+	Entries       map[Level]uint64        // Number of entries written to the output, per level
+	Errors        uint64                  // Number of errors writing to the output or a sink
+	QueueDepth    int                     // Number of entries waiting in the sinks implementing Queued
+	Dropped       uint64                  // Number of entries lost by the output or a sink, and not written to a dead-letter output
+	Sinks         []SinkHealth            // Health of each sink of the Logger, in the order they were added
+	Sizes         map[Level]SizeHistogram // Sizes of the rendered entries written to the output, per level
+}
+
+// SinkHealth is the state of a sink of a Logger, as reported in its Health.
+type SinkHealth struct {
+	Sink       string    // Type of the sink, e.g. "*loggo.Spool"
+	LastWrite  time.Time // Time of the last entry the sink received, zero if none
+	LastError  error     // Last error returned by the sink, after its retries, nil if none
+	Failures   uint64    // Number of entries the sink failed to receive, after its retries
+	QueueDepth int       // Number of entries waiting in the sink, if it implements Queued
+}
+
+// Queued is implemented by the sinks holding entries before delivering them, to report their queue depth in the
+// Health of a Logger.
+type Queued interface {
+	// QueueDepth returns the number of entries waiting to be delivered.
+	QueueDepth() int
+}
+
+// LatencyObserver receives the durations of the stages of the pipeline of a Logger, e.g. to feed histograms.
+// The durations are measured with the wall clock, regardless of the Clock of the Logger.
+type LatencyObserver interface {
+	// ObserveEncode receives the time taken to render an entry.
+	ObserveEncode(d time.Duration)
+	// ObserveWrite receives the time taken to write rendered entries to the outputs and sinks, including the wait
+	// for the output lock.
+	ObserveWrite(d time.Duration)
+}
+
+// health is the state of the logging pipeline, shared by a Logger and the loggers derived from it.
+// It is guarded by the output lock.
+type health struct {
+	clock         Clock
+	start         time.Time
+	drained       bool
+	lastWrite     time.Time
+	lastError     error
+	lastErrorTime time.Time
+	entries       map[Level]uint64
+	sizes         map[Level]SizeHistogram
+	errors        uint64
+	dropped       uint64
+}
+
+// sinkState is a sink of a Logger and its state. It is guarded by the output lock.
+type sinkState struct {
+	sink      Sink
+	filter    Filter
+	pending   atomic.Bool
+	lastWrite time.Time
+	lastError error
+	failures  uint64
+}
+
+// Health returns a snapshot of the state of the logging pipeline of the Logger: the last write and error of its
+// output, shared with the loggers derived from it, and the state of each of its sinks.
+//
+// Returns:
+//   - The Health of the Logger.
+//
+// Example:
+//
+//	http.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {
+//		if health := logger.Health(); health.LastError != nil {
+//			http.Error(w, "logging: "+health.LastError.Error(), http.StatusServiceUnavailable)
+//		}
+//	})
+func (l *Logger) Health() Health {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	h := Health{
+		LastWrite:     l.health.lastWrite,
+		LastError:     l.health.lastError,
+		LastErrorTime: l.health.lastErrorTime,
+		Entries:       make(map[Level]uint64, len(l.health.entries)),
+		Errors:        l.health.errors,
+		Dropped:       l.health.dropped,
+		Sinks:         make([]SinkHealth, 0, len(l.sinks)),
+		Sizes:         make(map[Level]SizeHistogram, len(l.health.sizes)),
+	}
+
+	for level, n := range l.health.entries {
+		h.Entries[level] = n
+	}
+
+	for level, histogram := range l.health.sizes {
+		h.Sizes[level] = histogram.clone()
+	}
+
+	for _, s := range l.sinks {
+		sh := SinkHealth{
+			Sink:      fmt.Sprintf("%T", s.sink),
+			LastWrite: s.lastWrite,
+			LastError: s.lastError,
+			Failures:  s.failures,
+		}
+
+		if queued, ok := s.sink.(Queued); ok {
+			sh.QueueDepth = queued.QueueDepth()
+			h.QueueDepth += sh.QueueDepth
+		}
+
+		h.Sinks = append(h.Sinks, sh)
+	}
+
+	return h
+}
+
+// recordWrite records the entries successfully written to the output. The lock must be held.
+func (h *health) recordWrite(entries []Entry) {
+	h.lastWrite = h.clock.Now()
+
+	for _, entry := range entries {
+		h.entries[entry.Level]++
+	}
+}
+
+// recordError records an error of the pipeline, and the number of entries it dropped. The lock must be held.
+func (h *health) recordError(err error, dropped int) {
+	h.lastError = err
+	h.lastErrorTime = h.clock.Now()
+	h.errors++
+	h.dropped += uint64(dropped)
+}
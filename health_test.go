@@ -0,0 +1,132 @@
+package loggo_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+func TestLogger_Health(t *testing.T) {
+	collector := &flakySink{down: true}
+
+	spool, err := loggo.NewSpool(collector, t.TempDir())
+	if err != nil {
+		t.Fatalf("NewSpool() error = %v", err)
+	}
+
+	failing := &failAfterSink{limit: -1}
+	logger, clock := loggotest.New(loggo.LevelInfo,
+		loggo.WithOutput(&loggotest.Recorder{}),
+		loggo.WithSink(spool),
+		loggo.WithSink(failing),
+	)
+
+	if health := logger.Health(); health.LastError != nil || !health.LastWrite.IsZero() || len(health.Sinks) != 2 {
+		t.Errorf("Logger.Health() = %+v, want a pristine health with 2 sinks", health)
+	}
+
+	logger.Info("first")
+	clock.Advance(time.Minute)
+	logger.Info("second")
+
+	health := logger.Health()
+
+	if !health.LastWrite.Equal(loggotest.Start.Add(time.Minute)) {
+		t.Errorf("Health.LastWrite = %v, want %v", health.LastWrite, loggotest.Start.Add(time.Minute))
+	}
+
+	if health.LastError == nil || health.LastError.Error() != "error writing to sink: connection reset" {
+		t.Errorf("Health.LastError = %v, want the error of the failing sink", health.LastError)
+	}
+
+	if health.QueueDepth != 2 || health.Dropped != 2 {
+		t.Errorf("Health.QueueDepth, Dropped = %d, %d, want 2, 2", health.QueueDepth, health.Dropped)
+	}
+
+	if s := health.Sinks[0]; s.Sink != "*loggo.Spool" || s.QueueDepth != 2 || s.Failures != 0 || !s.LastWrite.Equal(loggotest.Start.Add(time.Minute)) {
+		t.Errorf("Health.Sinks[0] = %+v, want the spool with 2 queued entries", s)
+	}
+
+	if s := health.Sinks[1]; s.Failures != 2 || s.LastError == nil || !s.LastWrite.IsZero() {
+		t.Errorf("Health.Sinks[1] = %+v, want 2 failures", s)
+	}
+}
+
+func TestLogger_Health_sizes(t *testing.T) {
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(&loggotest.Recorder{}), loggo.WithTemplate("{{.Message}}"),
+		loggo.WithMaxSize(4000))
+
+	logger.Info("small")
+	logger.Info(strings.Repeat("x", 100))
+
+	batch := logger.Batch()
+	batch.Error("one")
+	batch.Error(strings.Repeat("x", 2000))
+	_ = batch.Flush()
+
+	sizes := logger.Health().Sizes
+
+	want := map[loggo.Level]loggo.SizeHistogram{
+		loggo.LevelInfo: {
+			Bounds: []int{64, 256, 1 << 10, 4 << 10, 16 << 10, 64 << 10, 256 << 10, 1 << 20},
+			Counts: []uint64{1, 1, 0, 0, 0, 0, 0, 0, 0},
+			Count:  2,
+			Sum:    6 + 101,
+		},
+		loggo.LevelError: {
+			Bounds: []int{64, 256, 1 << 10, 4 << 10, 16 << 10, 64 << 10, 256 << 10, 1 << 20},
+			Counts: []uint64{1, 0, 0, 1, 0, 0, 0, 0, 0},
+			Count:  2,
+			Sum:    4 + 2001,
+		},
+	}
+
+	if !reflect.DeepEqual(sizes, want) {
+		t.Errorf("Health.Sizes = %+v, want %+v", sizes, want)
+	}
+}
+
+func TestLogger_Health_outputError(t *testing.T) {
+	logger, clock := loggotest.New(loggo.LevelInfo, loggo.WithOutput(errorWriter{}))
+	clock.Advance(time.Second)
+	logger.Info("lost")
+
+	child := logger.Child()
+	health := child.Health()
+
+	if health.LastError == nil || health.LastError.Error() != "error writing log: write failure" {
+		t.Errorf("Health.LastError = %v, want the output error", health.LastError)
+	}
+
+	if !health.LastErrorTime.Equal(loggotest.Start.Add(time.Second)) || health.Dropped != 1 {
+		t.Errorf("Health.LastErrorTime, Dropped = %v, %d, want %v, 1", health.LastErrorTime, health.Dropped, loggotest.Start.Add(time.Second))
+	}
+}
+
+// latencyRecorder is a LatencyObserver counting the observed durations.
+type latencyRecorder struct {
+	encodes, writes int
+}
+
+func (r *latencyRecorder) ObserveEncode(time.Duration) { r.encodes++ }
+
+func (r *latencyRecorder) ObserveWrite(time.Duration) { r.writes++ }
+
+func TestWithLatencyObserver(t *testing.T) {
+	observer := &latencyRecorder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(&loggotest.Recorder{}), loggo.WithLatencyObserver(observer))
+	logger.Info("first")
+
+	batch := logger.Batch()
+	batch.Info("second")
+	batch.Info("third")
+	_ = batch.Flush()
+
+	if observer.encodes != 3 || observer.writes != 2 {
+		t.Errorf("observed %d encodes and %d writes, want 3 and 2", observer.encodes, observer.writes)
+	}
+}
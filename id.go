@@ -0,0 +1,69 @@
+package loggo
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+// IDGenerator is a function that generates a unique ID for an entry logged at the given time.
+type IDGenerator func(t time.Time) string
+
+// crockford is the Crockford base32 alphabet used by ULIDs.
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// UUID is an IDGenerator of random (version 4) UUIDs, e.g. "1b4e28ba-2fa1-4d2b-883f-0016d3cca427".
+func UUID(time.Time) string {
+	var b [16]byte
+
+	_, _ = rand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	var s [36]byte
+
+	hex.Encode(s[0:8], b[0:4])
+	s[8] = '-'
+	hex.Encode(s[9:13], b[4:6])
+	s[13] = '-'
+	hex.Encode(s[14:18], b[6:8])
+	s[18] = '-'
+	hex.Encode(s[19:23], b[8:10])
+	s[23] = '-'
+	hex.Encode(s[24:], b[10:])
+
+	return string(s[:])
+}
+
+// ULID is an IDGenerator of ULIDs, e.g. "01FT78GF00MZ6BS0C7X2S4FTAE". ULIDs sort by the time of the entry, with
+// millisecond precision.
+func ULID(t time.Time) string {
+	var b [16]byte
+
+	ms := uint64(t.UnixMilli())
+	for i := 5; i >= 0; i-- {
+		b[i] = byte(ms)
+		ms >>= 8
+	}
+
+	_, _ = rand.Read(b[6:])
+
+	// The 128 bits of the ULID are encoded as 26 characters of 5 bits, with 2 leading padding bits.
+	var s [26]byte
+
+	for i := range s {
+		var v byte
+
+		for bit := i*5 - 2; bit < i*5+3; bit++ {
+			v <<= 1
+
+			if bit >= 0 && b[bit/8]&(0x80>>(bit%8)) != 0 {
+				v |= 1
+			}
+		}
+
+		s[i] = crockford[v]
+	}
+
+	return string(s[:])
+}
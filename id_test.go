@@ -0,0 +1,47 @@
+package loggo_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestUUID(t *testing.T) {
+	re := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+	first, second := loggo.UUID(fakeNow()), loggo.UUID(fakeNow())
+	if !re.MatchString(first) {
+		t.Errorf("UUID() = %q, want a version 4 UUID", first)
+	}
+
+	if first == second {
+		t.Errorf("UUID() generated %q twice", first)
+	}
+}
+
+func TestULID(t *testing.T) {
+	re := regexp.MustCompile(`^01FT78GF00[0-9A-HJKMNP-TV-Z]{16}$`)
+
+	first, second := loggo.ULID(fakeNow()), loggo.ULID(fakeNow())
+	if !re.MatchString(first) {
+		t.Errorf("ULID() = %q, want a ULID with the time prefix 01FT78GF00", first)
+	}
+
+	if first == second {
+		t.Errorf("ULID() generated %q twice", first)
+	}
+}
+
+func TestLogger_Log_entryID(t *testing.T) {
+	w := &strings.Builder{}
+	fixedID := func(time.Time) string { return "id-1" }
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Message}}"), loggo.WithSuffix(" log_id={{.ID}}"), loggo.WithEntryID(fixedID))
+	logger.Info("This is an info log message")
+
+	if want := "This is an info log message log_id=id-1\n"; w.String() != want {
+		t.Errorf("Logger.Info() = %q, want %q", w.String(), want)
+	}
+}
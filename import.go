@@ -0,0 +1,353 @@
+package loggo
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ImportFormat is the format of the log lines read by an Importer.
+type ImportFormat int
+
+// Available import formats.
+const (
+	// ImportStdlib is the format of the standard log package, with its default or microseconds date and time, and its
+	// optional file and line, e.g. "2024/09/03 15:04:05 main.go:10: message". A leading "[LEVEL]" or "LEVEL:" of the
+	// message sets the level of the entry.
+	ImportStdlib ImportFormat = iota
+	// ImportLogfmt is the logfmt format, e.g. `time=2024-09-03T15:04:05Z level=info msg="server started"`.
+	ImportLogfmt
+	// ImportJSON is the JSON lines format, as written by zap, zerolog, logrus, log/slog and loggo, e.g.
+	// `{"level":"info","ts":1725375845.5,"msg":"server started"}`.
+	ImportJSON
+)
+
+// importLevels are the level names of the other loggers, besides the names of the loggo levels.
+var importLevels = map[string]Level{
+	"warning":  LevelWarn,
+	"err":      LevelError,
+	"dpanic":   LevelError,
+	"crit":     LevelFatal,
+	"critical": LevelFatal,
+}
+
+// importTimeLayouts are the layouts of the times of the logfmt and JSON lines, tried in order.
+var importTimeLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05.000Z0700", TimeFormatDefault}
+
+// stdlibLine matches a line of the standard log package: its date, time, file and line, and message.
+var stdlibLine = regexp.MustCompile(`^(?:(\d{4}/\d{2}/\d{2}) )?(?:(\d{2}:\d{2}:\d{2}(?:\.\d+)?) )?` +
+	`(?:([^\s:]+\.go:\d+): )?(.*)$`)
+
+// stdlibLevel matches the level at the start of a message of the standard log package, e.g. "[WARN] " or "ERROR: ".
+var stdlibLevel = regexp.MustCompile(`^(?:\[([A-Za-z]+)\]|([A-Za-z]+):) `)
+
+// Importer reads the log lines written by other loggers as Entries, so existing log streams can be consolidated by
+// re-emitting them through loggo, see Import. It reads a line at a time, like a bufio.Scanner. The time, level,
+// message and caller of the lines are read from their well-known keys, e.g. "ts", "time" or "timestamp" for the time,
+// and the other keys become the fields of the entries. The lines without a level are read at LevelInfo, the ones
+// without a time have the zero time, and the JSON and logfmt lines that are malformed are read as the message of an
+// entry at LevelInfo, so no line is lost.
+type Importer struct {
+	scanner *bufio.Scanner
+	format  ImportFormat
+	entry   Entry
+	err     error
+}
+
+// NewImporter returns an Importer reading the lines of the reader, in the format.
+//
+// Parameters:
+//   - r: The reader of the log lines.
+//   - format: The ImportFormat of the lines.
+//
+// Returns:
+//   - A pointer to the Importer.
+//
+// Example:
+//
+//	importer := loggo.NewImporter(file, loggo.ImportJSON)
+//	for importer.Scan() {
+//		_ = sink.WriteEntry(importer.Entry())
+//	}
+//
+//	if err := importer.Err(); err != nil {
+//		log.Fatal(err)
+//	}
+func NewImporter(r io.Reader, format ImportFormat) *Importer {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxLineSize)
+
+	return &Importer{scanner: scanner, format: format}
+}
+
+// Scan reads the next entry, skipping the blank lines, available with Entry.
+//
+// Returns:
+//   - Whether an entry was read, false at the end of the input or on an error, see Err.
+func (i *Importer) Scan() bool {
+	for i.scanner.Scan() {
+		line := strings.TrimRight(i.scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		switch i.format {
+		case ImportStdlib:
+			i.entry = importStdlib(line)
+		case ImportLogfmt:
+			i.entry = importLogfmt(line)
+		case ImportJSON:
+			i.entry = importJSON(line)
+		default:
+			i.err = errors.New("unknown import format " + strconv.Itoa(int(i.format)))
+
+			return false
+		}
+
+		return true
+	}
+
+	if err := i.scanner.Err(); err != nil {
+		i.err = errors.New("error importing entries: " + err.Error())
+	}
+
+	return false
+}
+
+// Entry returns the entry read by the last call to Scan.
+func (i *Importer) Entry() Entry {
+	return i.entry
+}
+
+// Err returns the error that stopped Scan, nil at the end of the input.
+func (i *Importer) Err() error {
+	return i.err
+}
+
+// Import reads the log lines of the reader, in the format, and logs them to the Logger, preserving their level, time
+// and caller, like Replay, so they go through its Threshold, filters, output and sinks.
+//
+// Parameters:
+//   - r: The reader of the log lines.
+//   - format: The ImportFormat of the lines.
+//   - dst: The Logger to log the entries to.
+//
+// Returns:
+//   - The number of entries read.
+//   - An error if the lines could not be read, or an entry could not be logged, nil otherwise.
+//
+// Example:
+//
+//	file, _ := os.Open("legacy.log")
+//	defer file.Close()
+//
+//	n, err := loggo.Import(file, loggo.ImportStdlib, logger)
+func Import(r io.Reader, format ImportFormat, dst *Logger) (int, error) {
+	importer := NewImporter(r, format)
+
+	n := 0
+	for ; importer.Scan(); n++ {
+		if err := dst.replay(importer.Entry()); err != nil {
+			return n, err
+		}
+	}
+
+	return n, importer.Err()
+}
+
+// newImportedEntry returns an entry read at LevelInfo, with an unknown caller.
+func newImportedEntry(message string) Entry {
+	return Entry{Level: LevelInfo, Message: message, Caller: "unknown", Function: "unknown"}
+}
+
+// importStdlib returns the entry of a line of the standard log package.
+func importStdlib(line string) Entry {
+	match := stdlibLine.FindStringSubmatch(line)
+	entry := newImportedEntry(match[4])
+
+	switch {
+	case match[1] != "" && match[2] != "":
+		entry.Time, _ = time.ParseInLocation("2006/01/02 15:04:05", match[1]+" "+match[2], time.Local)
+	case match[1] != "":
+		entry.Time, _ = time.ParseInLocation("2006/01/02", match[1], time.Local)
+	}
+
+	if match[3] != "" {
+		entry.Caller = match[3]
+	}
+
+	if prefix := stdlibLevel.FindStringSubmatch(entry.Message); prefix != nil {
+		if level, ok := importLevel(prefix[1] + prefix[2]); ok {
+			entry.Level = level
+			entry.Message = entry.Message[len(prefix[0]):]
+		}
+	}
+
+	return entry
+}
+
+// importLogfmt returns the entry of a logfmt line, or of its raw text if it is malformed.
+func importLogfmt(line string) Entry {
+	var fields Fields
+
+	for rest := strings.TrimSpace(line); rest != ""; rest = strings.TrimLeft(rest, " \t") {
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 || strings.ContainsAny(rest[:eq], " \t\"") {
+			return newImportedEntry(line)
+		}
+
+		key := rest[:eq]
+		rest = rest[eq+1:]
+
+		var value string
+
+		if strings.HasPrefix(rest, `"`) {
+			end := closingQuote(rest)
+			if end < 0 {
+				return newImportedEntry(line)
+			}
+
+			unquoted, err := strconv.Unquote(rest[:end+1])
+			if err != nil {
+				return newImportedEntry(line)
+			}
+
+			value, rest = unquoted, rest[end+1:]
+		} else {
+			end := strings.IndexAny(rest, " \t")
+			if end < 0 {
+				end = len(rest)
+			}
+
+			value, rest = rest[:end], rest[end:]
+		}
+
+		fields = append(fields, F(key, value))
+	}
+
+	return importFields(fields)
+}
+
+// importJSON returns the entry of a JSON line, or of its raw text if it is malformed.
+func importJSON(line string) Entry {
+	var fields jsonFields
+	if !strings.HasPrefix(strings.TrimSpace(line), "{") || json.Unmarshal([]byte(line), &fields) != nil {
+		return newImportedEntry(line)
+	}
+
+	return importFields(Fields(fields))
+}
+
+// importFields returns the entry of the fields of a line, reading its time, level, message and caller from their
+// well-known keys, the first one found winning.
+func importFields(fields Fields) Entry {
+	entry := newImportedEntry("")
+
+	var seen [4]bool
+
+	for _, field := range fields {
+		var known bool
+
+		switch field.Key {
+		case "time", "ts", "timestamp", "@timestamp":
+			if t, ok := importTime(field.Value); !seen[0] && ok {
+				entry.Time, seen[0], known = t, true, true
+			}
+		case "level", "lvl", "severity":
+			if level, ok := importLevel(importString(field.Value)); !seen[1] && ok {
+				entry.Level, seen[1], known = level, true, true
+			}
+		case "message", "msg":
+			if !seen[2] {
+				entry.Message, seen[2], known = importString(field.Value), true, true
+			}
+		case "caller":
+			if !seen[3] {
+				entry.Caller, seen[3], known = importString(field.Value), true, true
+			}
+		}
+
+		if !known {
+			entry.Fields = append(entry.Fields, field)
+		}
+	}
+
+	return entry
+}
+
+// importLevel returns the level of a level name, of loggo or another logger, case-insensitively, and whether it is one.
+func importLevel(name string) (Level, bool) {
+	if level, ok := levelByName(name); ok {
+		return level, true
+	}
+
+	level, ok := importLevels[strings.ToLower(name)]
+
+	return level, ok
+}
+
+// importTime returns the time of a value, a string in one of the import layouts, or a Unix time in seconds,
+// milliseconds, microseconds or nanoseconds, guessed from its magnitude, and whether it is one.
+func importTime(value any) (time.Time, bool) {
+	if s, ok := value.(string); ok {
+		for _, layout := range importTimeLayouts {
+			if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+				return t, true
+			}
+		}
+	}
+
+	epoch, err := strconv.ParseFloat(importString(value), 64)
+	if err != nil || math.IsInf(epoch, 0) || math.IsNaN(epoch) {
+		return time.Time{}, false
+	}
+
+	switch {
+	case epoch < 1e11:
+		return time.UnixMicro(int64(epoch * 1e6)), true
+	case epoch < 1e14:
+		return time.UnixMicro(int64(epoch * 1e3)), true
+	case epoch < 1e17:
+		return time.UnixMicro(int64(epoch)), true
+	default:
+		return time.Unix(0, int64(epoch)), true
+	}
+}
+
+// importString returns the text of a value read from a line.
+func importString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		var buf bytes.Buffer
+		if err := json.NewEncoder(&buf).Encode(v); err != nil {
+			return ""
+		}
+
+		return strings.TrimSuffix(buf.String(), "\n")
+	}
+}
+
+// closingQuote returns the index of the quote closing the quoted string at the start of s, or -1.
+func closingQuote(s string) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,112 @@
+package loggo_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestImporter(t *testing.T) {
+	type testCase struct {
+		name   string
+		format loggo.ImportFormat
+		loc    *time.Location
+		input  string
+		want   []string
+	}
+
+	testCases := []testCase{
+		{
+			name:   "stdlib",
+			format: loggo.ImportStdlib,
+			loc:    time.Local,
+			input: "2024/09/03 15:04:05 server started\n" +
+				"2024/09/03 15:04:05.123456 main.go:10: [WARN] disk full\n" +
+				"\n" +
+				"ERROR: connection lost\n" +
+				"note: not a level\n",
+			want: []string{
+				"2024-09-03 15:04:05.000 INFO unknown server started []",
+				"2024-09-03 15:04:05.123 WARN main.go:10 disk full []",
+				"- ERROR unknown connection lost []",
+				"- INFO unknown note: not a level []",
+			},
+		},
+		{
+			name:   "logfmt",
+			format: loggo.ImportLogfmt,
+			loc:    time.UTC,
+			input: `time=2024-09-03T15:04:05Z level=warning msg="disk full" caller=disk.go:7 free=0` + "\n" +
+				`lvl=dpanic msg=unexpected ts=1725375845` + "\n" +
+				`msg="unterminated` + "\n",
+			want: []string{
+				"2024-09-03 15:04:05.000 WARN disk.go:7 disk full [free=0]",
+				"2024-09-03 15:04:05.000 ERROR unknown unexpected []",
+				`- INFO unknown msg="unterminated []`,
+			},
+		},
+		{
+			name:   "json",
+			format: loggo.ImportJSON,
+			loc:    time.UTC,
+			input: `{"level":"info","ts":1725375845.5,"caller":"app/main.go:12","msg":"zap","user":{"id":42}}` + "\n" +
+				`{"level":"error","time":1725375845000,"message":"zerolog","error":"timeout"}` + "\n" +
+				`{"time":"2024-09-03T15:04:05.25Z","level":"WARN","msg":"slog","level":"debug"}` + "\n" +
+				`plain text` + "\n",
+			want: []string{
+				`2024-09-03 15:04:05.500 INFO app/main.go:12 zap [user=map[id:42]]`,
+				"2024-09-03 15:04:05.000 ERROR unknown zerolog [error=timeout]",
+				"2024-09-03 15:04:05.250 WARN unknown slog [level=debug]",
+				"- INFO unknown plain text []",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			importer := loggo.NewImporter(strings.NewReader(tc.input), tc.format)
+
+			var got []string
+
+			for importer.Scan() {
+				entry := importer.Entry()
+
+				at := "-"
+				if !entry.Time.IsZero() {
+					at = entry.Time.In(tc.loc).Format("2006-01-02 15:04:05.000")
+				}
+
+				got = append(got, at+" "+entry.Level.String()+" "+entry.Caller+" "+entry.Message+" ["+
+					entry.Fields.String()+"]")
+			}
+
+			if err := importer.Err(); err != nil {
+				t.Fatal(err)
+			}
+
+			if strings.Join(got, "\n") != strings.Join(tc.want, "\n") {
+				t.Errorf("entries = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestImport(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithLocation(time.UTC),
+		loggo.WithTemplate("{{.Time}} {{.Level}} {{.Caller}} {{.Message}}"))
+
+	input := "2024/09/03 15:04:05 main.go:10: started\n2024/09/03 15:04:06 [DEBUG] discarded\n"
+
+	n, err := loggo.Import(strings.NewReader(input), loggo.ImportStdlib, logger)
+	if err != nil || n != 2 {
+		t.Fatalf("Import() = %d, %v, want 2 entries", n, err)
+	}
+
+	started := time.Date(2024, 9, 3, 15, 4, 5, 0, time.Local).UTC()
+	if want := started.Format(loggo.TimeFormatDefault) + " INFO main.go:10 started\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
@@ -0,0 +1,187 @@
+package loggo
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// journalFile is the name of the journal file, in the directory of a Journal.
+const journalFile = "journal.jsonl"
+
+// Journal is a Sink wrapping another sink, usually sending the entries over the network, that first appends each
+// entry to a local journal file, synced to disk, and only removes it from the journal once the wrapped sink received
+// it. The entries of a process crashing before shipping them are replayed to the wrapped sink when a Journal is opened
+// on the same directory, guaranteeing at-least-once delivery: an entry may be received twice if the process crashes
+// between shipping it and removing it from the journal. It is safe for concurrent use.
+//
+// The journal only holds the entries not shipped yet, so it stays small while the wrapped sink is healthy. To bound it
+// during an outage of the wrapped sink, wrap a Spool instead.
+type Journal struct {
+	mu    sync.Mutex
+	sink  Sink
+	path  string
+	count int
+}
+
+// NewJournal returns a Journal wrapping the sink, with its journal file in the directory, created if it does not
+// exist. The entries left in the journal by a previous process are replayed to the sink, and kept in the journal if
+// it fails to receive them.
+//
+// Parameters:
+//   - sink: The Sink to wrap.
+//   - dir: The directory of the journal file.
+//
+// Returns:
+//   - A pointer to the Journal.
+//   - An error if the directory could not be created, nil otherwise.
+//
+// Example:
+//
+//	journal, err := loggo.NewJournal(collector, "/var/lib/app/journal")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithSink(journal))
+func NewJournal(sink Sink, dir string) (*Journal, error) {
+	j := &Journal{
+		sink: sink,
+		path: filepath.Join(dir, journalFile),
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	if content, err := os.ReadFile(j.path); err == nil {
+		j.count = bytes.Count(content, []byte("\n"))
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	_ = j.ship()
+
+	return j, nil
+}
+
+// WriteEntry appends the entry to the journal, then ships the journaled entries to the wrapped sink in order.
+//
+// Returns:
+//   - An error if the entry could not be journaled, or the wrapped sink failed, nil otherwise. A journaled entry is
+//     shipped again on the next write, Flush, or when the journal is reopened, even if an error is returned.
+func (j *Journal) WriteEntry(entry Entry) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if err := j.append(entry); err != nil {
+		return err
+	}
+
+	return j.ship()
+}
+
+// Flush ships the journaled entries to the wrapped sink.
+//
+// Returns:
+//   - An error if the wrapped sink failed before every journaled entry was shipped, nil otherwise.
+func (j *Journal) Flush() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	return j.ship()
+}
+
+// QueueDepth returns the number of entries in the journal, so it is reported in the Health of a Logger.
+func (j *Journal) QueueDepth() int {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	return j.count
+}
+
+// append appends the entry to the journal file, and syncs it to disk. The lock must be held.
+func (j *Journal) append(entry Entry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return errors.New("error journaling entry: " + err.Error())
+	}
+
+	file, err := os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return errors.New("error journaling entry: " + err.Error())
+	}
+	defer file.Close()
+
+	if _, err = file.Write(append(line, '\n')); err != nil {
+		return errors.New("error journaling entry: " + err.Error())
+	}
+
+	if err = file.Sync(); err != nil {
+		return errors.New("error journaling entry: " + err.Error())
+	}
+
+	j.count++
+
+	return nil
+}
+
+// ship sends the journaled entries to the wrapped sink in order, keeping in the journal file the entries from the
+// first one the sink fails to receive. Lines left incomplete by a crash are skipped. The lock must be held.
+func (j *Journal) ship() error {
+	if j.count == 0 {
+		return nil
+	}
+
+	content, err := os.ReadFile(j.path)
+	if err != nil {
+		return errors.New("error reading journal: " + err.Error())
+	}
+
+	for start := 0; start < len(content); {
+		line, _, _ := bytes.Cut(content[start:], []byte("\n"))
+
+		var entry Entry
+		if json.Unmarshal(line, &entry) == nil {
+			if err = j.sink.WriteEntry(entry); err != nil {
+				return j.rewrite(content[start:], err)
+			}
+		}
+
+		start += len(line) + 1
+	}
+
+	if err = os.Remove(j.path); err != nil {
+		return errors.New("error removing journal: " + err.Error())
+	}
+
+	j.count = 0
+
+	return nil
+}
+
+// rewrite replaces the journal file with the remaining content, returning the error of the wrapped sink.
+// The lock must be held.
+func (j *Journal) rewrite(remaining []byte, sinkErr error) error {
+	if bytes.Count(remaining, []byte("\n")) == j.count {
+		return sinkErr
+	}
+
+	tmp := j.path + ".tmp"
+
+	if err := os.WriteFile(tmp, remaining, 0o644); err != nil {
+		return errors.New("error rewriting journal: " + err.Error())
+	}
+
+	if err := os.Rename(tmp, j.path); err != nil {
+		return errors.New("error rewriting journal: " + err.Error())
+	}
+
+	j.count = bytes.Count(remaining, []byte("\n"))
+
+	return sinkErr
+}
@@ -0,0 +1,83 @@
+package loggo_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestJournal(t *testing.T) {
+	dir := t.TempDir()
+	sink := &failAfterSink{limit: 1}
+
+	journal, err := loggo.NewJournal(sink, dir)
+	if err != nil {
+		t.Fatalf("NewJournal() error = %v", err)
+	}
+
+	if err = journal.WriteEntry(loggo.Entry{Message: "first"}); err != nil {
+		t.Errorf("Journal.WriteEntry() error = %v", err)
+	}
+
+	if _, err = os.Stat(filepath.Join(dir, "journal.jsonl")); !os.IsNotExist(err) {
+		t.Errorf("journal file exists after shipping, error = %v", err)
+	}
+
+	for _, msg := range []string{"second", "third"} {
+		if err = journal.WriteEntry(loggo.Entry{Message: msg}); err == nil {
+			t.Error("Journal.WriteEntry() error = nil while the sink fails, want error")
+		}
+	}
+
+	if got := journal.QueueDepth(); got != 2 {
+		t.Errorf("Journal.QueueDepth() = %d, want 2", got)
+	}
+
+	sink.limit = 2
+
+	if err = journal.Flush(); err == nil {
+		t.Error("Journal.Flush() error = nil, want error")
+	}
+
+	if got := journal.QueueDepth(); got != 1 {
+		t.Errorf("Journal.QueueDepth() = %d after partial shipping, want 1", got)
+	}
+
+	if got, want := sink.messages, []string{"first", "second"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sink received %v, want %v", got, want)
+	}
+}
+
+func TestNewJournal_recovery(t *testing.T) {
+	dir := t.TempDir()
+
+	// Simulates a process crashing after journaling two entries, the last one only partially.
+	line, err := json.Marshal(loggo.Entry{Level: loggo.LevelWarn, Message: "unshipped"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content := append(append(line, '\n'), line[:10]...)
+	if err = os.WriteFile(filepath.Join(dir, "journal.jsonl"), append(content, '\n'), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	sink := &failAfterSink{limit: 10}
+
+	journal, err := loggo.NewJournal(sink, dir)
+	if err != nil {
+		t.Fatalf("NewJournal() error = %v", err)
+	}
+
+	if got, want := sink.messages, []string{"unshipped"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sink received %v, want %v", got, want)
+	}
+
+	if got := journal.QueueDepth(); got != 0 {
+		t.Errorf("Journal.QueueDepth() = %d after recovery, want 0", got)
+	}
+}
@@ -0,0 +1,132 @@
+package loggo
+
+// LogKV logs a message at the given log level, with fields attached to this entry only, given as alternating keys and
+// values, e.g. "user", id, or Field values, without deriving a Logger with With. If the log level is below the
+// Threshold, the message is not logged, and the fields are not built. If an error occurs while logging the message,
+// it is ignored.
+//
+// Parameters:
+//   - level: The log level of the message.
+//   - message: The message to log.
+//   - keyvals: The alternating keys and values, or Field values, of the fields. A key without a value is given the
+//     value "!MISSING".
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo)
+//	logger.LogKV(loggo.LevelInfo, "handled", "user", 42, "latency", time.Since(start))
+func (l *Logger) LogKV(level Level, message string, keyvals ...any) {
+	_ = l.log(level, text(message), l.kvFields(level, keyvals)...)
+}
+
+// TraceKV logs a message at the LevelTrace, with fields attached to this entry only, see LogKV.
+//
+// Parameters:
+//   - message: The trace message to log.
+//   - keyvals: The alternating keys and values, or Field values, of the fields.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelTrace)
+//	logger.TraceKV("frame decoded", "bytes", n)
+func (l *Logger) TraceKV(message string, keyvals ...any) {
+	_ = l.log(LevelTrace, text(message), l.kvFields(LevelTrace, keyvals)...)
+}
+
+// DebugKV logs a message at the LevelDebug, with fields attached to this entry only, see LogKV.
+//
+// Parameters:
+//   - message: The debug message to log.
+//   - keyvals: The alternating keys and values, or Field values, of the fields.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelDebug)
+//	logger.DebugKV("cache miss", "key", key)
+func (l *Logger) DebugKV(message string, keyvals ...any) {
+	_ = l.log(LevelDebug, text(message), l.kvFields(LevelDebug, keyvals)...)
+}
+
+// InfoKV logs a message at the LevelInfo, with fields attached to this entry only, see LogKV.
+//
+// Parameters:
+//   - message: The info message to log.
+//   - keyvals: The alternating keys and values, or Field values, of the fields.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo)
+//	logger.InfoKV("handled", "user", 42, "latency", time.Since(start))
+func (l *Logger) InfoKV(message string, keyvals ...any) {
+	_ = l.log(LevelInfo, text(message), l.kvFields(LevelInfo, keyvals)...)
+}
+
+// WarnKV logs a message at the LevelWarn, with fields attached to this entry only, see LogKV.
+//
+// Parameters:
+//   - message: The warn message to log.
+//   - keyvals: The alternating keys and values, or Field values, of the fields.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelWarn)
+//	logger.WarnKV("slow query", "table", "orders", "ms", 1200)
+func (l *Logger) WarnKV(message string, keyvals ...any) {
+	_ = l.log(LevelWarn, text(message), l.kvFields(LevelWarn, keyvals)...)
+}
+
+// ErrorKV logs a message at the LevelError, with fields attached to this entry only, see LogKV.
+//
+// Parameters:
+//   - message: The error message to log.
+//   - keyvals: The alternating keys and values, or Field values, of the fields.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelError)
+//	logger.ErrorKV("payment failed", "order", id, "err", err)
+func (l *Logger) ErrorKV(message string, keyvals ...any) {
+	_ = l.log(LevelError, text(message), l.kvFields(LevelError, keyvals)...)
+}
+
+// PanicKV logs a message at the LevelPanic, with fields attached to this entry only, see LogKV, then panics with the
+// message, see Panic.
+//
+// Parameters:
+//   - message: The panic message to log.
+//   - keyvals: The alternating keys and values, or Field values, of the fields.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo)
+//	logger.PanicKV("invariant broken", "order", id)
+func (l *Logger) PanicKV(message string, keyvals ...any) {
+	_ = l.log(LevelPanic, text(message), l.kvFields(LevelPanic, keyvals)...)
+	l.panicMessage(message)
+}
+
+// FatalKV logs a message at the LevelFatal, with fields attached to this entry only, see LogKV, then exits the
+// process, see Fatal.
+//
+// Parameters:
+//   - message: The fatal message to log.
+//   - keyvals: The alternating keys and values, or Field values, of the fields.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelFatal)
+//	logger.FatalKV("cannot start", "err", err)
+func (l *Logger) FatalKV(message string, keyvals ...any) {
+	_ = l.log(LevelFatal, text(message), l.kvFields(LevelFatal, keyvals)...)
+	l.exitFatal()
+}
+
+// kvFields returns the fields of alternating keys and values, or nil if the messages of the level are discarded.
+func (l *Logger) kvFields(level Level, keyvals []any) Fields {
+	l.checkKeyvals(keyvals)
+	if len(keyvals) == 0 || l.off() || l.discards(level) {
+		return nil
+	}
+
+	return fieldsOf(keyvals)
+}
@@ -0,0 +1,40 @@
+package loggo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestLogger_KV(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Level}} {{.Message}} {{.Fields}}"),
+		loggo.WithExitFunc(nil))
+	request := logger.With("request_id", 7)
+
+	logger.DebugKV("discarded", "user", 42)
+	request.InfoKV("handled", "user", 42, "path", "/a b")
+	request.WarnKV("slow", loggo.F("ms", 1200))
+	logger.ErrorKV("failed", "orphan")
+	logger.FatalKV("stopped")
+	logger.LogKV(loggo.LevelInfo, "done", "ok", true)
+
+	want := "INFO handled request_id=7 user=42 path=\"/a b\"\nWARN slow request_id=7 ms=1200\n" +
+		"ERROR failed orphan=!MISSING\nFATAL stopped \nINFO done ok=true\n"
+	if w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
+
+func TestLogger_KV_discardedAllocs(t *testing.T) {
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(&strings.Builder{}))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		logger.DebugKV("discarded", "user", 42, "path", "/a")
+	})
+
+	if allocs != 0 {
+		t.Errorf("Logger.DebugKV() allocations = %v when discarded, want 0", allocs)
+	}
+}
@@ -1,19 +1,34 @@
 package loggo
 
+import (
+	"cmp"
+	"errors"
+	"flag"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
 // Level represents an available log level.
 //
-// The log levels are ordered by severity, with LevelDebug being the lowest and LevelFatal being the highest.
+// The log levels are ordered by severity, see Rank, with LevelTrace being the lowest and LevelFatal being the highest.
 // The levels are:
+// - LevelTrace: Used for very detailed tracing, switched off separately from debugging.
 // - LevelDebug: Used for debugging purposes.
 // - LevelInfo: Used to log general information about the application.
 // - LevelWarn: Used to log warnings about potential issues.
 // - LevelError: Used to log errors that do not cause the application to stop.
+// - LevelPanic: Used to log errors that cause the current goroutine to panic.
 // - LevelFatal: Used to log fatal errors that cause the application to stop.
+//
+// Custom levels can be added with RegisterLevel.
 type Level byte
 
-// Available log levels.
+// Available log levels. Their values never change, so the levels stored or sent as numbers keep their meaning: the
+// levels added later take the next values, and the levels are ordered by Rank rather than by value.
 const (
-	// LevelDebug is the lowest level and is mostly used for debugging purposes.
+	// LevelDebug is mostly used for debugging purposes.
 	LevelDebug Level = iota
 	// LevelInfo is used to log general information about the application.
 	LevelInfo
@@ -23,9 +38,276 @@ const (
 	LevelError
 	// LevelFatal is used to log fatal errors that cause the application to stop.
 	LevelFatal
+	// LevelTrace is the lowest level and is used for very detailed tracing, more verbose than debugging.
+	LevelTrace
+	// LevelPanic is used to log errors that cause the current goroutine to panic.
+	LevelPanic
 )
 
-// String returns the string representation of the log level.
+// levelRanks are the ranks of the built-in levels, by value, see Level.Rank.
+var levelRanks = [...]int{
+	LevelDebug: 10,
+	LevelInfo:  20,
+	LevelWarn:  30,
+	LevelError: 40,
+	LevelFatal: 50,
+	LevelTrace: 5,
+	LevelPanic: 45,
+}
+
+// levels is the registry of the custom levels, by value.
+var levels = struct {
+	sync.RWMutex
+	names map[Level]string
+}{names: map[Level]string{}}
+
+// RegisterLevel registers a custom level, with its name, so it can be logged with Logger.Log, compared to the
+// Threshold, and parsed back from its name by the filter expressions and readers. A custom level ranks at its value,
+// see Rank, so it is ordered between the built-in levels ranking around it, e.g. 25 between LevelInfo (20) and
+// LevelWarn (30), and has the severity and color of the highest built-in level ranking below it, see SeverityProfile.
+// Levels are meant to be registered once, in package variables or init functions: registering a built-in level, a
+// level ranking as a built-in one, a level twice, or an empty name or one already used panics.
+//
+// Parameters:
+//   - level: The value of the level, also its rank, from 7.
+//   - name: The name of the level, e.g. "NOTICE".
+//
+// Example:
+//
+//	var LevelNotice = loggo.Level(25)
+//
+//	func init() {
+//		loggo.RegisterLevel(LevelNotice, "NOTICE")
+//	}
+//
+//	logger.Log(LevelNotice, "configuration reloaded")
+func RegisterLevel(level Level, name string) {
+	if level.builtin() {
+		panic("loggo: level " + level.String() + " is built-in")
+	}
+
+	if rank := level.Rank(); levelRanks[level.base()] == rank {
+		panic("loggo: level " + strconv.Itoa(rank) + " ranks as " + level.base().String())
+	}
+
+	if name == "" {
+		panic("loggo: empty level name")
+	}
+
+	if _, ok := levelByName(name); ok {
+		panic("loggo: level name " + strconv.Quote(name) + " already used")
+	}
+
+	levels.Lock()
+	defer levels.Unlock()
+
+	if _, ok := levels.names[level]; ok {
+		panic("loggo: level " + strconv.Itoa(int(level)) + " registered twice")
+	}
+
+	levels.names[level] = name
+}
+
+// String returns the string representation of the log level, its name for a custom level, or "LEVEL(n)" for a level
+// not registered.
 func (l Level) String() string {
-	return [...]string{"DEBUG", "INFO", "WARN", "ERROR", "FATAL"}[l]
+	if l.builtin() {
+		return [...]string{"DEBUG", "INFO", "WARN", "ERROR", "FATAL", "TRACE", "PANIC"}[l]
+	}
+
+	levels.RLock()
+	name, ok := levels.names[l]
+	levels.RUnlock()
+
+	if !ok {
+		return "LEVEL(" + strconv.Itoa(int(l)) + ")"
+	}
+
+	return name
+}
+
+// Rank returns the rank of the level on the severity scale ordering the levels, from 5 for LevelTrace, 10 for
+// LevelDebug, 20 for LevelInfo, 30 for LevelWarn, 40 for LevelError and 45 for LevelPanic to 50 for LevelFatal, and its
+// value for a custom level. The Threshold, the filter expressions and the other level comparisons use the rank.
+//
+// Returns:
+//   - The rank of the level.
+//
+// Example:
+//
+//	if entry.Level.Rank() >= loggo.LevelWarn.Rank() {
+//		alert(entry)
+//	}
+func (l Level) Rank() int {
+	if l.builtin() {
+		return levelRanks[l]
+	}
+
+	return int(l)
+}
+
+// Priority returns the syslog priority of the log level, from 7 (debug) for LevelTrace and LevelDebug to 2 (critical)
+// for LevelPanic and LevelFatal.
+func (l Level) Priority() int {
+	return SeveritySyslog(l).Number
+}
+
+// ParseLevel returns the log level with the given name, case-insensitively, ignoring the surrounding spaces, including
+// the custom levels registered with RegisterLevel, and the levels not registered written as "LEVEL(n)".
+//
+// Parameters:
+//   - name: The name of the level, e.g. "warn".
+//
+// Returns:
+//   - The Level.
+//   - An error if no level has the name, nil otherwise.
+//
+// Example:
+//
+//	level, err := loggo.ParseLevel(os.Getenv("LOG_LEVEL"))
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	logger := loggo.New(level)
+func ParseLevel(name string) (Level, error) {
+	trimmed := strings.TrimSpace(name)
+	if level, ok := levelByName(trimmed); ok {
+		return level, nil
+	}
+
+	value, ok := strings.CutPrefix(strings.ToUpper(trimmed), "LEVEL(")
+	if value, closed := strings.CutSuffix(value, ")"); ok && closed {
+		if n, err := strconv.ParseUint(value, 10, 8); err == nil {
+			return Level(n), nil
+		}
+	}
+
+	return 0, errors.New("unknown level " + strconv.Quote(name))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the level as its name, so it can be written to
+// configuration files, e.g. in JSON or YAML.
+func (l Level) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding the level from its name, case-insensitively, see
+// ParseLevel.
+//
+// Example:
+//
+//	var config struct {
+//		Level loggo.Level `json:"level"`
+//	}
+//
+//	err := json.Unmarshal([]byte(`{"level":"warn"}`), &config)
+func (l *Level) UnmarshalText(text []byte) error {
+	level, err := ParseLevel(string(text))
+	if err != nil {
+		return err
+	}
+
+	*l = level
+
+	return nil
+}
+
+// Set implements flag.Value, setting the level from its name, see ParseLevel, so a *Level can be used as a command
+// line flag with flag.Var, or the pflag package.
+//
+// Example:
+//
+//	level := loggo.LevelInfo
+//	flag.Var(&level, "log-level", "minimum level of the logs")
+//	flag.Parse()
+func (l *Level) Set(value string) error {
+	return l.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of the type of the level flags, shown by the usage of the pflag package.
+func (l *Level) Type() string {
+	return "level"
+}
+
+// LevelFlag defines a level flag of the command line, with its name, default value and usage, like flag.String.
+//
+// Parameters:
+//   - name: The name of the flag, e.g. "log-level".
+//   - value: The default level.
+//   - usage: The usage of the flag.
+//
+// Returns:
+//   - A pointer to the Level set by the flag once the command line is parsed.
+//
+// Example:
+//
+//	level := loggo.LevelFlag("log-level", loggo.LevelInfo, "minimum level of the logs")
+//	flag.Parse()
+//
+//	logger := loggo.New(*level)
+func LevelFlag(name string, value Level, usage string) *Level {
+	level := value
+	flag.Var(&level, name, usage)
+
+	return &level
+}
+
+// Levels returns the built-in levels and the custom levels registered with RegisterLevel, ordered by rank.
+//
+// Returns:
+//   - The levels, from LevelTrace.
+//
+// Example:
+//
+//	for _, level := range loggo.Levels() {
+//		fmt.Println(level)
+//	}
+func Levels() []Level {
+	all := []Level{LevelTrace, LevelDebug, LevelInfo, LevelWarn, LevelError, LevelPanic, LevelFatal}
+
+	levels.RLock()
+	for level := range levels.names {
+		all = append(all, level)
+	}
+	levels.RUnlock()
+
+	slices.SortFunc(all, func(a, b Level) int {
+		return cmp.Compare(a.Rank(), b.Rank())
+	})
+
+	return all
+}
+
+// base returns the built-in level of the same severity as the level: the level itself, or the highest built-in level
+// ranking at or below a custom level.
+func (l Level) base() Level {
+	if l.builtin() {
+		return l
+	}
+
+	base := LevelTrace
+	for level, rank := range levelRanks {
+		if rank <= l.Rank() && rank > base.Rank() {
+			base = Level(level)
+		}
+	}
+
+	return base
+}
+
+// builtin reports whether the level is a built-in level.
+func (l Level) builtin() bool {
+	return int(l) < len(levelRanks)
+}
+
+// levelByName returns the log level with the given name, case-insensitively, and whether it exists.
+func levelByName(name string) (Level, bool) {
+	for _, level := range Levels() {
+		if strings.EqualFold(name, level.String()) {
+			return level, true
+		}
+	}
+
+	return 0, false
 }
@@ -0,0 +1,244 @@
+package loggo_test
+
+import (
+	"encoding/json"
+	"flag"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+const (
+	levelNotice = loggo.Level(25)
+	levelAudit  = loggo.Level(60)
+)
+
+func init() {
+	loggo.RegisterLevel(levelNotice, "NOTICE")
+	loggo.RegisterLevel(levelAudit, "AUDIT")
+}
+
+func TestRegisterLevel(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelFatal, loggo.WithOutput(w), loggo.WithTimeProvider(fakeNow),
+		loggo.WithSeverityProfile(loggo.SeverityOTel),
+		loggo.WithTemplate("{{.Level}} {{.Severity.Name}} {{.Message}}"))
+
+	logger.Log(levelAudit, "invoice approved")
+	logger.Log(loggo.LevelError, "discarded by the threshold")
+
+	if want := "AUDIT FATAL invoice approved\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+
+	filter, err := loggo.ParseFilter("level>fatal && level==audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !filter(loggo.Entry{Level: levelAudit}) {
+		t.Error("filter() = false, want the custom level to match")
+	}
+
+	if got, want := loggo.Level(200).String(), "LEVEL(200)"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestRegisterLevel_ordering(t *testing.T) {
+	type testCase struct {
+		name      string
+		threshold loggo.Level
+		want      string
+	}
+
+	testCases := []testCase{
+		{name: "below", threshold: loggo.LevelInfo, want: "NOTICE INFO configuration reloaded\n"},
+		{name: "above", threshold: loggo.LevelWarn, want: ""},
+		{name: "fatal", threshold: loggo.LevelFatal, want: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			logger := loggo.New(tc.threshold, loggo.WithOutput(w), loggo.WithSeverityProfile(loggo.SeverityOTel),
+				loggo.WithTemplate("{{.Level}} {{.Severity.Name}} {{.Message}}"))
+
+			logger.Log(levelNotice, "configuration reloaded")
+
+			if w.String() != tc.want {
+				t.Errorf("output = %q, want %q", w.String(), tc.want)
+			}
+		})
+	}
+
+	filter, err := loggo.ParseFilter("level>info && level<warn")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !filter(loggo.Entry{Level: levelNotice}) {
+		t.Error("filter() = false, want NOTICE between INFO and WARN")
+	}
+}
+
+func TestRegisterLevel_invalid(t *testing.T) {
+	type testCase struct {
+		name  string
+		level loggo.Level
+		label string
+		want  string
+	}
+
+	testCases := []testCase{
+		{name: "built-in", level: loggo.LevelWarn, label: "NOTICE", want: "loggo: level WARN is built-in"},
+		{name: "twice", level: levelAudit, label: "SECURITY", want: "loggo: level 60 registered twice"},
+		{name: "built-in rank", level: 20, label: "SECURITY", want: "loggo: level 20 ranks as INFO"},
+		{name: "name used", level: 26, label: "info", want: `loggo: level name "info" already used`},
+		{name: "empty name", level: 26, label: "", want: "loggo: empty level name"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != tc.want {
+					t.Errorf("recover() = %v, want %q", r, tc.want)
+				}
+			}()
+
+			loggo.RegisterLevel(tc.level, tc.label)
+		})
+	}
+}
+
+func TestLevel_values(t *testing.T) {
+	type testCase struct {
+		level loggo.Level
+		value int
+		rank  int
+	}
+
+	testCases := []testCase{
+		{level: loggo.LevelDebug, value: 0, rank: 10},
+		{level: loggo.LevelInfo, value: 1, rank: 20},
+		{level: loggo.LevelWarn, value: 2, rank: 30},
+		{level: loggo.LevelError, value: 3, rank: 40},
+		{level: loggo.LevelFatal, value: 4, rank: 50},
+		{level: loggo.LevelTrace, value: 5, rank: 5},
+		{level: loggo.LevelPanic, value: 6, rank: 45},
+		{level: levelAudit, value: 60, rank: 60},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.level.String(), func(t *testing.T) {
+			if int(tc.level) != tc.value || tc.level.Rank() != tc.rank {
+				t.Errorf("value = %d, Rank() = %d, want %d, %d", tc.level, tc.level.Rank(), tc.value, tc.rank)
+			}
+		})
+	}
+
+	var zero loggo.Level
+	if zero != loggo.LevelDebug {
+		t.Errorf("zero Level = %s, want DEBUG", zero)
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	type testCase struct {
+		name    string
+		want    loggo.Level
+		wantErr string
+	}
+
+	testCases := []testCase{
+		{name: "warn", want: loggo.LevelWarn},
+		{name: " Trace ", want: loggo.LevelTrace},
+		{name: "FATAL", want: loggo.LevelFatal},
+		{name: "audit", want: levelAudit},
+		{name: "LEVEL(200)", want: 200},
+		{name: "LEVEL(300)", wantErr: `unknown level "LEVEL(300)"`},
+		{name: "verbose", wantErr: `unknown level "verbose"`},
+		{name: "", wantErr: `unknown level ""`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := loggo.ParseLevel(tc.name)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Errorf("ParseLevel() error = %v, want %q", err, tc.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil || got != tc.want {
+				t.Errorf("ParseLevel() = %v, %v, want %v", got, err, tc.want)
+			}
+		})
+	}
+}
+
+func TestLevel_text(t *testing.T) {
+	var config struct {
+		Levels []loggo.Level `json:"levels"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"levels":["debug","ERROR","audit"]}`), &config); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := `{"levels":["DEBUG","ERROR","AUDIT"]}`; string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	if err := json.Unmarshal([]byte(`{"levels":["loud"]}`), &config); err == nil {
+		t.Error("json.Unmarshal() error = nil, want an unknown level error")
+	}
+}
+
+func TestLevel_flag(t *testing.T) {
+	fs := flag.NewFlagSet("app", flag.ContinueOnError)
+	fs.SetOutput(&strings.Builder{})
+
+	level := loggo.LevelInfo
+	fs.Var(&level, "log-level", "minimum level of the logs")
+
+	if err := fs.Parse([]string{"-log-level=debug"}); err != nil || level != loggo.LevelDebug {
+		t.Errorf("Parse() = %v, level = %s, want DEBUG", err, level)
+	}
+
+	if err := fs.Parse([]string{"-log-level", "loud"}); err == nil {
+		t.Error("Parse() error = nil, want an unknown level error")
+	}
+
+	if got, want := level.Type(), "level"; got != want {
+		t.Errorf("Type() = %q, want %q", got, want)
+	}
+
+	defaulted := loggo.LevelFlag("loggo-test-level", loggo.LevelWarn, "minimum level of the logs")
+	if *defaulted != loggo.LevelWarn {
+		t.Errorf("LevelFlag() = %s, want WARN", *defaulted)
+	}
+
+	if err := flag.Set("loggo-test-level", "error"); err != nil || *defaulted != loggo.LevelError {
+		t.Errorf("flag.Set() = %v, level = %s, want ERROR", err, *defaulted)
+	}
+}
+
+func TestLevels(t *testing.T) {
+	var names []string
+	for _, level := range loggo.Levels() {
+		names = append(names, level.String())
+	}
+
+	if got, want := strings.Join(names, ","), "TRACE,DEBUG,INFO,NOTICE,WARN,ERROR,PANIC,FATAL,AUDIT"; got != want {
+		t.Errorf("Levels() = %s, want %s", got, want)
+	}
+}
@@ -1,35 +1,97 @@
 package loggo
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"os"
-	"runtime"
 	"sync"
-	"text/template"
+	"sync/atomic"
 	"time"
 )
 
+// callerDepth is the number of stack frames between getCaller and the caller of a Logger method.
+const callerDepth = 4
+
 // Logger is the structure that holds the logger information.
-// It includes the log level Threshold, output destination, message template, and time provider.
+// It includes the log level threshold, output destination, message template, and clock.
 type Logger struct {
-	Context        context.Context // Context for the logger
-	Threshold      Level           // Minimum log level to output
-	mu             sync.RWMutex    // Ensures thread-safe access to the logger
-	output         io.Writer       // Destination for log output
-	template       string          // Template for log messages
-	now            TimeProvider    // Function to get the current time
-	timeFormat     string          // Format for the time in the log message
-	maxSize        int             // Maximum size of the log message
-	callerProvider CallerProvider  // Function to get the caller information
-	preHooks       []Hook          // Pre-hooks to run before logging
-	postHooks      []Hook          // Post-hooks to run after logging
+	Context         context.Context // Context for the logger
+	mu              *sync.Mutex     // Ensures thread-safe access to the output, shared with derived loggers
+	parent          *Logger         // Logger this one is derived from, which closes what they share, nil if none
+	closed          *atomic.Bool    // Whether the logger is closed
+	output          io.Writer       // Destination for log output
+	ownsOutput      bool            // Whether the output was set on the logger, instead of inherited from its parent
+	splitOutput     io.Writer       // Destination for the log output at the split level and above, nil to disable it
+	ownsSplitOutput bool            // Whether the split output was set on the logger, instead of inherited from its parent
+	splitLevel      Level           // Minimum level of the log output written to the split output
+	errorOutput     io.Writer       // Destination of the internal failures of the logger, nil to discard them
+	extraOutputs    []io.Writer     // Additional destinations for log output
+	template        string          // Template for log messages
+	suffix          string          // Template appended to the template, before the line ending
+	format          Format          // Format of the log lines
+	encoder         Encoder         // Encoder of the log lines, nil to use the built-in encoder of the format
+	templateDefs    TemplateDefs    // Named templates available to the template, by name
+	templates       *templateCache  // Compiled template, shared with the copies rendering the same template
+	dataProviders   []dataProvider  // Providers of the custom values of the template data
+	lineEnding      LineEnding      // Line ending appended to each log line
+	clock           Clock           // Clock to get the current time and tickers
+	timeFormat      string          // Format for the time in the log message
+	timeBucket      TimeBucket      // Window the time is floored to for {{.TimeBucket}}, and key of its field
+	location        *time.Location  // Location used to render the time, nil keeps the provider's location
+	maxSize         int             // Maximum size of the log message
+	maxFieldSize    int             // Maximum size of the rendered value of a field, 0 for no limit
+	maxFields       int             // Maximum number of fields of an entry, 0 for no limit
+	fields          Fields          // Fields attached to each entry, see With
+	fieldFuncs      []FieldsFunc    // Functions returning dynamic fields appended to each entry
+	ctxFields       []ContextFields // Functions returning fields from the context appended to each entry
+	callerProvider  CallerProvider  // Function to get the caller information, nil for runtime.Caller
+	callerSkip      int             // Additional stack frames to skip by the default caller provider
+	callerFormat    CallerFormat    // Format of the caller file path
+	component       bool            // Whether to derive the component from the caller package
+	componentPrefix string          // Prefix trimmed from the caller package to derive the component
+	service         Service         // Service emitting the log entries
+	build           Build           // Build of the binary emitting the log entries
+	schema          string          // Schema version stamped on each entry, empty for none
+	severity        SeverityProfile // Profile of the severities rendered by the template and encoders
+	exit            func(code int)  // Function exiting the process after a Fatal message, nil to not exit
+	idGenerator     IDGenerator     // Generator of the unique ID of each entry, nil to disable IDs
+	checksum        Checksum        // Checksum appended to each line
+	preHooks        []Hook          // Pre-hooks to run before logging
+	postHooks       []Hook          // Post-hooks to run after logging
+	sinks           []*sinkState    // Sinks receiving the logged entries, with their state
+	sinkRetries     int             // Number of times a failed sink write is retried
+	writeTimeout    time.Duration   // Maximum duration of a sink write, 0 for no limit
+	fallback        io.Writer       // Destination of the error entries the output or sinks failed to receive
+	deadLetter      io.Writer       // Destination of the entries the sinks failed to receive, nil to discard them
+	filters         []Filter        // Filters deciding which entries are logged
+	transforms      *transformChain // Transformers of the accepted entries, linked to the ones of the parent logger
+	dropHook        DropHook        // Hook called for the entries dropped before being written, nil to skip it
+	drops           *drops          // Counts of the dropped entries by reason, shared with derived loggers
+	aggregations    []*aggregation  // Aggregations summarizing the entries of a level over a window
+	alerts          []*alert        // Alerts watching the rate of the entries of a level
+	disabled        bool            // Whether the logger discards every message
+	muted           *atomic.Bool    // Whether the output is muted, shared with derived loggers
+	threshold       *atomic.Uint32  // Minimum log level to output, copied by derived loggers unless it is dynamic
+	dynamicLevel    *dynamicLevel   // Reloads of the threshold from a file on SIGHUP, nil to disable them
+	contextKeys     map[string]any  // Keys of the context values available to templates, by name
+	health          *health         // State of the logging pipeline, shared with derived loggers
+	latency         LatencyObserver // Observer of the durations of the pipeline stages, nil to skip measuring them
+	colorMode       ColorMode       // When to color the log lines
+	colored         bool            // Whether the log lines are colored, resolved from the color mode and output
+	summary         bool            // Whether to log the summary of the levels logged on Close
+	deadlineWarn    bool            // Whether to note the deadline of the context, and escalate messages after it
+	trace           bool            // Whether to emit the entries as runtime/trace user log events
+	traceLevel      Level           // Minimum level of the entries emitted as runtime/trace user log events
+	stackFormat     StackFormat     // Format of the stack traces attached to the entries
+	development     bool            // Whether misuse panics instead of being tolerated
+	priorityPrefix  bool            // Whether to prefix each line with the syslog priority of its level
 }
 
 // New creates a new Logger with the given Threshold and options.
-// The default output is os.Stdout, the default template is "%s [%5s]: %s", and the default time provider is time.Now.
+// The default output is os.Stdout, the default template is "%s [%5s]: %s", and the default clock is based on time.Now.
 //
 // Parameters:
 //   - Threshold: Minimum log level to output.
@@ -43,28 +105,42 @@ type Logger struct {
 //	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(os.Stderr))
 //	logger.Info("This is an info message")
 func New(threshold Level, options ...Option) *Logger {
-	defaultCaller := func() (pc uintptr, file string, line int, ok bool) {
-		pc, file, line, ok = runtime.Caller(5)
-
-		return
-	}
 	log := &Logger{
-		Threshold:      threshold,
-		Context:        context.Background(),
-		output:         os.Stdout,
-		template:       "{{.Time}} [{{printf \"%5s\" .Level}}]: {{.Message}}",
-		now:            time.Now,
-		timeFormat:     "2006-01-02 15:04:05",
-		maxSize:        1000,
-		callerProvider: defaultCaller,
-		preHooks:       []Hook{},
-		postHooks:      []Hook{},
+		Context:    context.Background(),
+		mu:         &sync.Mutex{},
+		closed:     &atomic.Bool{},
+		muted:      &atomic.Bool{},
+		threshold:  newThreshold(threshold),
+		output:     os.Stdout,
+		template:   "{{.Time}} [{{.Color}}{{printf \"%5s\" .Level}}{{.Reset}}]: {{.Message}}{{with .Fields}} {{.}}{{end}}",
+		clock:      systemClock{now: time.Now},
+		timeFormat: TimeFormatDefault,
+		lineEnding: LineEndingLF,
+		maxSize:    1000,
+		preHooks:   []Hook{},
+		postHooks:  []Hook{},
+		sinks:      []*sinkState{},
+		transforms: &transformChain{},
+		templates:  &templateCache{},
+		exit:       os.Exit,
 	}
 
 	for _, option := range options {
 		option(log)
 	}
 
+	log.checkConfig()
+	log.resolveColor()
+	log.startAggregations()
+	log.startDynamicLevel()
+	_, _ = log.compiledTemplate()
+	log.health = &health{
+		clock:   log.clock,
+		start:   log.clock.Now(),
+		entries: map[Level]uint64{},
+		sizes:   map[Level]SizeHistogram{},
+	}
+
 	return log
 }
 
@@ -80,7 +156,7 @@ func New(threshold Level, options ...Option) *Logger {
 //	logger := loggo.New(loggo.LevelInfo)
 //	logger.Log(loggo.LevelInfo, "This is an info message")
 func (l *Logger) Log(level Level, message string) {
-	_ = l.LogE(level, message)
+	_ = l.log(level, text(message))
 }
 
 // LogE logs a message at the given log level and returns an error if the message could not be logged.
@@ -101,33 +177,309 @@ func (l *Logger) Log(level Level, message string) {
 //		log.Fatal(err)
 //	}
 func (l *Logger) LogE(level Level, message string) error {
+	return l.log(level, text(message))
+}
+
+// log logs a message at the given log level, with the given fields. Every exported logging method must call it
+// directly, so the caller information is always at the same stack depth.
+func (l *Logger) log(level Level, msg message, fields ...Field) error {
+	level, message, ok := l.admit(l.Context, level, msg)
+	if !ok {
+		return nil
+	}
+
+	return l.logEntry(newEntry(level, message, l, fields...), message)
+}
+
+// admit runs the pre-hooks on the message, and returns it with its level, escalated if the context is done, and
+// whether it passes the threshold.
+func (l *Logger) admit(ctx context.Context, level Level, msg message) (Level, string, bool) {
+	l.checkOpen()
+	if l.off() || l.discards(level) {
+		return level, "", false
+	}
+
+	message := msg.String()
 	for _, hook := range l.preHooks {
 		hook(l, &message)
 	}
 
-	if l.Threshold > level {
+	level = l.escalate(ctx, level)
+
+	return level, message, level.Rank() >= l.GetThreshold().Rank()
+}
+
+// logEntry writes the entry if the filters accept it, then runs the post-hooks on the message.
+func (l *Logger) logEntry(entry Entry, message string) error {
+	entry, ok := l.accept(entry)
+	if !ok {
 		return nil
 	}
 
-	data := getTemplateData(level, message, l)
+	if err := l.write(entry); err != nil {
+		return err
+	}
+
+	for _, hook := range l.postHooks {
+		hook(l, &message)
+	}
+
+	return nil
+}
+
+// writeOutput writes the rendered entries to the output, or to the split output for the entries at the split level
+// and above.
+func (l *Logger) writeOutput(rendered []byte, ends []int, entries []Entry) error {
+	if l.splitOutput == nil {
+		_, err := l.output.Write(rendered)
+
+		return err
+	}
+
+	var low, high []byte
+
+	start := 0
+	for i, entry := range entries {
+		end := len(rendered)
+		if ends != nil {
+			end = ends[i]
+		}
+
+		if entry.Level.Rank() >= l.splitLevel.Rank() {
+			high = append(high, rendered[start:end]...)
+		} else {
+			low = append(low, rendered[start:end]...)
+		}
+
+		start = end
+	}
+
+	if len(low) > 0 {
+		if _, err := l.output.Write(low); err != nil {
+			return err
+		}
+	}
+
+	if len(high) > 0 {
+		if _, err := l.splitOutput.Write(high); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// accept reports whether the entry passes all the filters of the logger, and is not suppressed by one of its
+// aggregations, counting it in its alerts and aggregations. It returns the entry transformed by the transformers of the
+// logger, which can discard it too, with its fields limited.
+func (l *Logger) accept(entry Entry) (Entry, bool) {
+	for _, filter := range l.filters {
+		if !filter(entry) {
+			l.drop(entry, DropFiltered)
+
+			return entry, false
+		}
+	}
+
+	l.alert(entry)
+
+	suppressed := false
+
+	for _, a := range l.aggregations {
+		if a.add(entry) {
+			suppressed = true
+		}
+	}
+
+	if suppressed {
+		l.drop(entry, DropSuppressed)
+
+		return entry, false
+	}
+
+	transformed, ok := l.transforms.transform(entry)
+	if !ok {
+		l.drop(entry, DropTransformed)
+
+		return transformed, false
+	}
+
+	transformed.Fields = l.limitFields(transformed.Fields)
+
+	return transformed, true
+}
+
+// write renders the entry to the output of the logger and sends it to its sinks.
+func (l *Logger) write(entry Entry) error {
+	var buf bytes.Buffer
+	if err := l.render(&buf, entry); err != nil {
+		l.reportError(err)
+
+		return err
+	}
+
+	err := l.emit(buf.Bytes(), nil, entry)
+	l.reportError(err)
+
+	return err
+}
+
+// reportError writes an internal failure of the logger, if any, to its error output, if set.
+func (l *Logger) reportError(err error) {
+	if err == nil || l.errorOutput == nil {
+		return
+	}
+
+	_, _ = io.WriteString(l.errorOutput, "loggo: "+err.Error()+"\n")
+}
+
+// render renders the entry with the template of the logger, appending it to the buffer with its checksum, if enabled,
+// and the line ending.
+func (l *Logger) render(buf *bytes.Buffer, entry Entry) error {
+	if l.latency != nil {
+		defer func(start time.Time) { l.latency.ObserveEncode(time.Since(start)) }(time.Now())
+	}
 
-	tmpl, err := template.New("log").Parse(l.template + "\n")
+	line, err := l.lineEncoder().Encode(entry)
 	if err != nil {
-		return errors.New("error parsing template: " + err.Error())
+		return err
+	}
+
+	start := buf.Len()
+	buf.Write(line)
+	buf.WriteString(string(l.lineEnding))
+
+	if l.priorityPrefix {
+		l.prefixPriority(buf, start, entry.Level)
+	}
+
+	if l.checksum != ChecksumNone {
+		buf.Truncate(buf.Len() - len(l.lineEnding))
+		l.checksum.appendChecksum(buf, start)
+		buf.WriteString(string(l.lineEnding))
+	}
+
+	return nil
+}
+
+// emit writes the rendered entries to the outputs of the logger at once, and sends the entries to its sinks.
+// The ends are the offsets of the end of each entry in the rendered bytes, nil for a single entry. With a split
+// output, the entries at the split level and above are written to it instead of the output, at once too.
+// With a dead-letter output, a failing sink does not prevent the other sinks from receiving the entries.
+func (l *Logger) emit(rendered []byte, ends []int, entries ...Entry) error {
+	if l.latency != nil {
+		defer func(start time.Time) { l.latency.ObserveWrite(time.Since(start)) }(time.Now())
 	}
 
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
-	if err = tmpl.Execute(l.output, data); err != nil {
-		return errors.New("error executing template: " + err.Error())
+	if err := l.writeOutput(rendered, ends, entries); err != nil {
+		err = errors.New("error writing log: " + err.Error())
+		l.health.recordError(err, len(entries))
+		l.writeFallback(entries...)
+
+		return err
 	}
 
-	for _, hook := range l.postHooks {
-		hook(l, &message)
+	l.health.recordWrite(entries)
+	l.health.recordSizes(rendered, ends, entries)
+	l.traceEntries(entries)
+
+	for _, output := range l.extraOutputs {
+		if _, err := output.Write(rendered); err != nil {
+			err = errors.New("error writing log: " + err.Error())
+			l.health.recordError(err, 0)
+
+			return err
+		}
 	}
 
-	return nil
+	var sinkErr error
+
+	for _, entry := range entries {
+		received, failed := false, false
+
+		for _, sink := range l.sinks {
+			if sink.filter != nil && !sink.filter(entry) {
+				continue
+			}
+
+			err := l.writeSink(sink, entry)
+			if err == nil {
+				received = true
+
+				continue
+			}
+
+			if l.deadLetter == nil {
+				if !received {
+					l.writeFallback(entry)
+				}
+
+				return err
+			}
+
+			failed = true
+
+			if sinkErr == nil {
+				sinkErr = err
+			}
+		}
+
+		if failed && !received {
+			l.writeFallback(entry)
+		}
+	}
+
+	return sinkErr
+}
+
+// LogAt logs a message at the given log level with an explicit time, instead of the current time of the clock.
+// It is useful when re-emitting historical events or importing logs from another system.
+// If the log level is below the Threshold, the message is not logged. If an error occurs while logging the message, it is ignored.
+//
+// Parameters:
+//   - t: The time of the message.
+//   - level: The log level of the message.
+//   - message: The message to log.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo)
+//	logger.LogAt(event.Time, loggo.LevelInfo, event.Description)
+func (l *Logger) LogAt(t time.Time, level Level, message string) {
+	_ = l.at(t).log(level, text(message))
+}
+
+// LogAtE logs a message at the given log level with an explicit time, and returns an error if the message could not be
+// logged. If the log level is below the Threshold, the message is not logged.
+//
+// Parameters:
+//   - t: The time of the message.
+//   - level: The log level of the message.
+//   - message: The message to log.
+//
+// Returns:
+//   - An error if the message could not be logged, nil otherwise.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo)
+//	err := logger.LogAtE(event.Time, loggo.LevelInfo, event.Description)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (l *Logger) LogAtE(t time.Time, level Level, message string) error {
+	return l.at(t).log(level, text(message))
+}
+
+// at returns a copy of the logger whose clock is fixed at the given time.
+func (l *Logger) at(t time.Time) *Logger {
+	c := l.clone()
+	c.clock = fixedClock{Clock: l.clock, now: t}
+
+	return c
 }
 
 // Logf logs a formatted message at the given log level.
@@ -143,7 +495,7 @@ func (l *Logger) LogE(level Level, message string) error {
 //	logger := loggo.New(loggo.LevelInfo)
 //	logger.Logf(loggo.LevelInfo, "This is an info message with a %s", "format")
 func (l *Logger) Logf(level Level, format string, args ...any) {
-	l.Log(level, fmt.Sprintf(format, args...))
+	_ = l.log(level, sprintf(format, args))
 }
 
 // LogfE logs a formatted message at the given log level and returns an error if the message could not be logged.
@@ -165,7 +517,34 @@ func (l *Logger) Logf(level Level, format string, args ...any) {
 //		log.Fatal(err)
 //	}
 func (l *Logger) LogfE(level Level, format string, args ...any) error {
-	return l.LogE(level, fmt.Sprintf(format, args...))
+	return l.log(level, sprintf(format, args))
+}
+
+// Trace logs a message at the LevelTrace. If an error occurs while logging the message, it is ignored.
+//
+// Parameters:
+//   - message: The trace message to log.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelTrace)
+//	logger.Trace("This is a trace message")
+func (l *Logger) Trace(message string) {
+	_ = l.log(LevelTrace, text(message))
+}
+
+// Tracef logs a formatted message at the LevelTrace. If an error occurs while logging the message, it is ignored.
+//
+// Parameters:
+//   - format: The format string for the trace message.
+//   - args: The arguments for the format string.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelTrace)
+//	logger.Tracef("This is a trace message with a %s", "format")
+func (l *Logger) Tracef(format string, args ...any) {
+	_ = l.log(LevelTrace, sprintf(format, args))
 }
 
 // Debug logs a message at the LevelDebug. If an error occurs while logging the message, it is ignored.
@@ -178,7 +557,7 @@ func (l *Logger) LogfE(level Level, format string, args ...any) error {
 //	logger := loggo.New(loggo.LevelDebug)
 //	logger.Debug("This is a debug message")
 func (l *Logger) Debug(message string) {
-	l.Log(LevelDebug, message)
+	_ = l.log(LevelDebug, text(message))
 }
 
 // Debugf logs a formatted message at the LevelDebug. If an error occurs while logging the message, it is ignored.
@@ -192,7 +571,7 @@ func (l *Logger) Debug(message string) {
 //	logger := loggo.New(loggo.LevelDebug)
 //	logger.Debugf("This is a debug message with a %s", "format")
 func (l *Logger) Debugf(format string, args ...any) {
-	l.Logf(LevelDebug, format, args...)
+	_ = l.log(LevelDebug, sprintf(format, args))
 }
 
 // Info logs a message at the LevelInfo. If an error occurs while logging the message, it is ignored.
@@ -205,7 +584,7 @@ func (l *Logger) Debugf(format string, args ...any) {
 //	logger := loggo.New(loggo.LevelInfo)
 //	logger.Info("This is an info message")
 func (l *Logger) Info(message string) {
-	l.Log(LevelInfo, message)
+	_ = l.log(LevelInfo, text(message))
 }
 
 // Infof logs a formatted message at the LevelInfo. If an error occurs while logging the message, it is ignored.
@@ -219,7 +598,7 @@ func (l *Logger) Info(message string) {
 //	logger := loggo.New(loggo.LevelInfo)
 //	logger.Infof("This is an info message with a %s", "format")
 func (l *Logger) Infof(format string, args ...any) {
-	l.Logf(LevelInfo, format, args...)
+	_ = l.log(LevelInfo, sprintf(format, args))
 }
 
 // Warn logs a message at the LevelWarn. If an error occurs while logging the message, it is ignored.
@@ -232,7 +611,7 @@ func (l *Logger) Infof(format string, args ...any) {
 //	logger := loggo.New(loggo.LevelWarn)
 //	logger.Warn("This is a warn message")
 func (l *Logger) Warn(message string) {
-	l.Log(LevelWarn, message)
+	_ = l.log(LevelWarn, text(message))
 }
 
 // Warnf logs a formatted message at the LevelWarn. If an error occurs while logging the message, it is ignored.
@@ -246,7 +625,7 @@ func (l *Logger) Warn(message string) {
 //	logger := loggo.New(loggo.LevelWarn)
 //	logger.Warnf("This is a warn message with a %s", "format")
 func (l *Logger) Warnf(format string, args ...any) {
-	l.Logf(LevelWarn, format, args...)
+	_ = l.log(LevelWarn, sprintf(format, args))
 }
 
 // Error logs a message at the LevelError. If an error occurs while logging the message, it is ignored.
@@ -259,7 +638,7 @@ func (l *Logger) Warnf(format string, args ...any) {
 //	logger := loggo.New(loggo.LevelError)
 //	logger.Error("This is an error message")
 func (l *Logger) Error(message string) {
-	l.Log(LevelError, message)
+	_ = l.log(LevelError, text(message))
 }
 
 // Errorf logs a formatted message at the LevelError. If an error occurs while logging the message, it is ignored.
@@ -273,10 +652,46 @@ func (l *Logger) Error(message string) {
 //	logger := loggo.New(loggo.LevelError)
 //	logger.Errorf("This is an error message with a %s", "format")
 func (l *Logger) Errorf(format string, args ...any) {
-	l.Logf(LevelError, format, args...)
+	_ = l.log(LevelError, sprintf(format, args))
+}
+
+// Panic logs a message at the LevelPanic, then panics with the message, even if it is discarded by the Threshold,
+// filters or Mute, so deferred functions run and the panic can be recovered. A copy returned by If with a false
+// condition skips the whole call, without panicking. If an error occurs while logging the message, it is ignored.
+//
+// Parameters:
+//   - message: The panic message to log.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo)
+//	logger.Panic("This is a panic message")
+func (l *Logger) Panic(message string) {
+	_ = l.log(LevelPanic, text(message))
+	l.panicMessage(message)
+}
+
+// Panicf logs a formatted message at the LevelPanic, then panics with the message, see Panic. If an error occurs while
+// logging the message, it is ignored.
+//
+// Parameters:
+//   - format: The format string for the panic message.
+//   - args: The arguments for the format string.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo)
+//	logger.Panicf("This is a panic message with a %s", "format")
+func (l *Logger) Panicf(format string, args ...any) {
+	message := fmt.Sprintf(format, args...)
+	_ = l.log(LevelPanic, text(message))
+	l.panicMessage(message)
 }
 
-// Fatal logs a message at the LevelFatal. If an error occurs while logging the message, it is ignored.
+// Fatal logs a message at the LevelFatal, then flushes the sinks and outputs of the Logger and exits the process with
+// the code 1, see WithExitFunc, even if the message is discarded by the Threshold, filters or Mute. A copy returned by
+// If with a false condition skips the whole call, without exiting. If an error occurs while logging the message, it is
+// ignored.
 //
 // Parameters:
 //   - message: The fatal message to log.
@@ -286,10 +701,12 @@ func (l *Logger) Errorf(format string, args ...any) {
 //	logger := loggo.New(loggo.LevelFatal)
 //	logger.Fatal("This is a fatal message")
 func (l *Logger) Fatal(message string) {
-	l.Log(LevelFatal, message)
+	_ = l.log(LevelFatal, text(message))
+	l.exitFatal()
 }
 
-// Fatalf logs a formatted message at the LevelFatal. If an error occurs while logging the message, it is ignored.
+// Fatalf logs a formatted message at the LevelFatal, then flushes the sinks and outputs of the Logger and exits the
+// process with the code 1, see Fatal. If an error occurs while logging the message, it is ignored.
 //
 // Parameters:
 //   - format: The format string for the fatal message.
@@ -300,5 +717,6 @@ func (l *Logger) Fatal(message string) {
 //	logger := loggo.New(loggo.LevelFatal)
 //	logger.Fatalf("This is a fatal message with a %s", "format")
 func (l *Logger) Fatalf(format string, args ...any) {
-	l.Logf(LevelFatal, format, args...)
+	_ = l.log(LevelFatal, sprintf(format, args))
+	l.exitFatal()
 }
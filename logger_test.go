@@ -2,10 +2,14 @@ package loggo_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"runtime"
 	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/hvpaiva/loggo"
 )
@@ -15,6 +19,12 @@ var fakeNow = func() time.Time {
 }
 var fakeNowString = "2022-01-25 00:00:00"
 
+type fakeClock struct{}
+
+func (fakeClock) Now() time.Time { return fakeNow() }
+
+func (fakeClock) NewTicker(d time.Duration) loggo.Ticker { return nil }
+
 var okCallerProvider = func() (pc uintptr, file string, line int, ok bool) {
 	return 0, "file", 1, true
 }
@@ -143,9 +153,15 @@ func TestLogger(t *testing.T) {
 	}
 
 	w := &strings.Builder{}
-	log := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTimeProvider(fakeNow))
+	log := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTimeProvider(fakeNow), loggo.WithExitFunc(nil))
 
 	testCases := []testCase{
+		{
+			name:    "trace",
+			logger:  log.Trace,
+			message: "This is an trace log message",
+			want:    "",
+		},
 		{
 			name:    "debug",
 			logger:  log.Debug,
@@ -202,7 +218,7 @@ func TestLogger_format(t *testing.T) {
 	}
 
 	w := &strings.Builder{}
-	log := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTimeProvider(fakeNow))
+	log := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTimeProvider(fakeNow), loggo.WithExitFunc(nil))
 
 	testCases := []testCase{
 		{
@@ -269,7 +285,7 @@ func TestLogger_LogfE(t *testing.T) {
 			name:     "error parsing template",
 			message:  "This is an info log message",
 			template: "{{.Level",
-			wantErr:  "error parsing template: template: log:2: unclosed action started at log:1",
+			wantErr:  "error parsing template: template: log:1: unclosed action",
 		},
 		{
 			name:     "error executing template",
@@ -376,16 +392,26 @@ func ExampleLogger_Errorf() {
 	// Output: 2022-01-25 00:00:00 [ERROR]: This is an error log message with a "format"
 }
 
+func ExampleLogger_Trace() {
+	logger := loggo.New(loggo.LevelTrace, loggo.WithTimeProvider(fakeNow))
+	logger.Trace("This is a trace log message")
+	// Output: 2022-01-25 00:00:00 [TRACE]: This is a trace log message
+}
+
 func ExampleLogger_Fatal() {
-	logger := loggo.New(loggo.LevelFatal, loggo.WithTimeProvider(fakeNow))
+	logger := loggo.New(loggo.LevelFatal, loggo.WithTimeProvider(fakeNow),
+		loggo.WithExitFunc(func(code int) { fmt.Println("exit", code) }))
 	logger.Fatal("This is a fatal log message")
 	// Output: 2022-01-25 00:00:00 [FATAL]: This is a fatal log message
+	// exit 1
 }
 
 func ExampleLogger_Fatalf() {
-	logger := loggo.New(loggo.LevelFatal, loggo.WithTimeProvider(fakeNow))
+	logger := loggo.New(loggo.LevelFatal, loggo.WithTimeProvider(fakeNow),
+		loggo.WithExitFunc(func(code int) { fmt.Println("exit", code) }))
 	logger.Fatalf("This is a fatal log message with a %q", "format")
 	// Output: 2022-01-25 00:00:00 [FATAL]: This is a fatal log message with a "format"
+	// exit 1
 }
 
 func ExampleLogger_Log_maxSize() {
@@ -394,6 +420,61 @@ func ExampleLogger_Log_maxSize() {
 	// Output: 2022-01-25 00:00:00 [ INFO]: This is an
 }
 
+func TestLogger_fieldLimits(t *testing.T) {
+	type testCase struct {
+		name    string
+		options []loggo.Option
+		want    string
+	}
+
+	testCases := []testCase{
+		{name: "no limits", want: "message a=short b=0123456789 c=3\n"},
+		{
+			name:    "max field size",
+			options: []loggo.Option{loggo.WithMaxFieldSize(5)},
+			want:    "message a=short b=01234...(truncated) c=3\n",
+		},
+		{
+			name:    "max fields",
+			options: []loggo.Option{loggo.WithMaxFields(1)},
+			want:    "message a=short fields_dropped=2\n",
+		},
+		{
+			name:    "both",
+			options: []loggo.Option{loggo.WithMaxFields(2), loggo.WithMaxFieldSize(3)},
+			want:    "message a=sho...(truncated) b=012...(truncated) fields_dropped=1\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			options := append([]loggo.Option{loggo.WithOutput(w), loggo.WithTemplate("{{.Message}} {{.Fields}}")}, tc.options...)
+			logger := loggo.New(loggo.LevelInfo, options...)
+
+			release := loggo.PushFields(loggo.F("a", "short"), loggo.F("b", "0123456789"), loggo.F("c", 3))
+			logger.Info("message")
+			release()
+
+			if w.String() != tc.want {
+				t.Errorf("output = %q, want %q", w.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestLogger_fieldLimits_utf8(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Message}} {{.Fields}}"),
+		loggo.WithMaxFieldSize(4))
+
+	logger.With("name", "aéé").Info("message")
+
+	if want := "message name=aé...(truncated)\n"; w.String() != want || !utf8.ValidString(w.String()) {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
+
 func ExampleLogger_Log_template() {
 	logger := loggo.New(loggo.LevelInfo, loggo.WithTimeProvider(fakeNow), loggo.WithTemplate("[{{.Level}}]: {{.Message}}"))
 	logger.Log(loggo.LevelInfo, "This is an info log message")
@@ -435,7 +516,8 @@ func ExampleLogger_Log_context() {
 		fmt.Printf("Trace ID: %q\n", l.Context.Value("trace_id"))
 	}
 
-	logger := loggo.New(loggo.LevelInfo, loggo.WithTimeProvider(fakeNow), loggo.WithContext(ctx), loggo.WithPostHook(postHook))
+	logger := loggo.New(loggo.LevelInfo, loggo.WithTimeProvider(fakeNow), loggo.WithContext(ctx), loggo.WithPostHook(postHook),
+		loggo.WithExitFunc(nil))
 	logger.Log(loggo.LevelInfo, "This is an info log message")
 	logger.Fatal("This is a fatal log message")
 	// Output: 2022-01-25 00:00:00 [ INFO]: This is an info log message
@@ -446,7 +528,7 @@ func ExampleLogger_Log_context() {
 
 func ExampleLogger_Log_preHook() {
 	preHook := func(l *loggo.Logger, msg *string) {
-		l.Threshold = loggo.LevelWarn
+		l.SetThreshold(loggo.LevelWarn)
 	}
 
 	logger := loggo.New(loggo.LevelInfo, loggo.WithTimeProvider(fakeNow), loggo.WithPreHook(preHook))
@@ -488,3 +570,456 @@ func ExampleLogger_Log_postHook() {
 	// Output: 2022-01-25 00:00:00 [ INFO]: This is an info log message, count: 0
 	// 2022-01-25 00:00:00 [FATAL]: This is a fatal log message, count: 1
 }
+
+func ExampleLogger_Log_location() {
+	loc := time.FixedZone("BRT", -3*60*60)
+	logger := loggo.New(loggo.LevelInfo, loggo.WithTimeProvider(fakeNow), loggo.WithLocation(loc))
+	logger.Log(loggo.LevelInfo, "This is an info log message")
+	// Output: 2022-01-24 21:00:00 [ INFO]: This is an info log message
+}
+
+func ExampleLogger_Log_utc() {
+	localNow := func() time.Time {
+		return fakeNow().In(time.FixedZone("BRT", -3*60*60))
+	}
+
+	logger := loggo.New(loggo.LevelInfo, loggo.WithTimeProvider(localNow), loggo.WithUTC())
+	logger.Log(loggo.LevelInfo, "This is an info log message")
+	// Output: 2022-01-25 00:00:00 [ INFO]: This is an info log message
+}
+
+func ExampleLogger_Log_timeFormatPreset() {
+	logger := loggo.New(loggo.LevelInfo, loggo.WithTimeProvider(fakeNow), loggo.WithTimeFormat(loggo.TimeFormatRFC3339Nano))
+	logger.Log(loggo.LevelInfo, "This is an info log message")
+	// Output: 2022-01-25T00:00:00Z [ INFO]: This is an info log message
+}
+
+func ExampleLogger_Log_timeFormatEpoch() {
+	logger := loggo.New(loggo.LevelInfo, loggo.WithTimeProvider(fakeNow), loggo.WithTimeFormat(loggo.TimeFormatEpochMillis))
+	logger.Log(loggo.LevelInfo, "This is an info log message")
+	// Output: 1643068800000 [ INFO]: This is an info log message
+}
+
+func TestLogger_Log_timeFormat(t *testing.T) {
+	type testCase struct {
+		name   string
+		format string
+		want   string
+	}
+
+	testCases := []testCase{
+		{name: "default", format: loggo.TimeFormatDefault, want: "2022-01-25 00:00:00\n"},
+		{name: "millis", format: loggo.TimeFormatMillis, want: "2022-01-25 00:00:00.000\n"},
+		{name: "kitchen", format: loggo.TimeFormatKitchen, want: "12:00AM\n"},
+		{name: "epoch seconds", format: loggo.TimeFormatEpochSeconds, want: "1643068800\n"},
+		{name: "epoch millis", format: loggo.TimeFormatEpochMillis, want: "1643068800000\n"},
+		{name: "epoch nanos", format: loggo.TimeFormatEpochNanos, want: "1643068800000000000\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTimeProvider(fakeNow), loggo.WithTimeFormat(tc.format), loggo.WithTemplate("{{.Time}}"))
+			logger.Info("This is an info log message")
+
+			if w.String() != tc.want {
+				t.Errorf("Logger.Log() = %q, want %q", w.String(), tc.want)
+			}
+		})
+	}
+}
+
+func ExampleLogger_Log_clock() {
+	logger := loggo.New(loggo.LevelInfo, loggo.WithClock(fakeClock{}))
+	logger.Log(loggo.LevelInfo, "This is an info log message")
+	// Output: 2022-01-25 00:00:00 [ INFO]: This is an info log message
+}
+
+type errorSink struct{}
+
+func (errorSink) WriteEntry(loggo.Entry) error { return errors.New("sink failure") }
+
+func TestLogger_LogE_sinkError(t *testing.T) {
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(io.Discard), loggo.WithSink(errorSink{}))
+	err := logger.LogE(loggo.LevelInfo, "This is an info log message")
+
+	if want := "error writing to sink: sink failure"; err == nil || err.Error() != want {
+		t.Errorf("Logger.LogE() error = %v, want %q", err, want)
+	}
+}
+
+func TestLogger_Log_caller(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelTrace, loggo.WithOutput(w), loggo.WithTemplate("{{.Caller}}"),
+		loggo.WithExitFunc(nil))
+
+	_, file, line, _ := runtime.Caller(0)
+	logger.Log(loggo.LevelInfo, "log")
+	logger.Logf(loggo.LevelInfo, "%s", "logf")
+	_ = logger.LogE(loggo.LevelInfo, "logE")
+	_ = logger.LogfE(loggo.LevelInfo, "%s", "logfE")
+	logger.Trace("trace")
+	logger.Tracef("%s", "tracef")
+	logger.Debug("debug")
+	logger.Debugf("%s", "debugf")
+	logger.Info("info")
+	logger.Infof("%s", "infof")
+	logger.Warn("warn")
+	logger.Warnf("%s", "warnf")
+	logger.Error("error")
+	logger.Errorf("%s", "errorf")
+	logger.Fatal("fatal")
+	logger.Fatalf("%s", "fatalf")
+	logger.LogKV(loggo.LevelInfo, "logKV")
+	logger.TraceKV("traceKV")
+	logger.DebugKV("debugKV")
+	logger.InfoKV("infoKV")
+	logger.WarnKV("warnKV")
+	logger.ErrorKV("errorKV")
+	logger.FatalKV("fatalKV")
+
+	var want strings.Builder
+	for i := 1; i <= 23; i++ {
+		want.WriteString(fmt.Sprintf("%s:%d\n", file, line+i))
+	}
+
+	if w.String() != want.String() {
+		t.Errorf("Logger.Log() callers = %q, want %q", w.String(), want.String())
+	}
+}
+
+func logWrapped(logger *loggo.Logger, message string) {
+	logger.Info(message)
+}
+
+func TestLogger_Log_callerSkip(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Caller}}"), loggo.WithCallerSkip(1))
+
+	_, file, line, _ := runtime.Caller(0)
+	logWrapped(logger, "wrapped")
+
+	if want := fmt.Sprintf("%s:%d\n", file, line+1); w.String() != want {
+		t.Errorf("Logger.Info() caller = %q, want %q", w.String(), want)
+	}
+}
+
+func TestLogger_Log_callerFormat(t *testing.T) {
+	type testCase struct {
+		name   string
+		format loggo.CallerFormat
+		file   string
+		want   string
+	}
+
+	testCases := []testCase{
+		{name: "full", format: loggo.CallerFormatFull, file: "/home/user/app/db/db.go", want: "/home/user/app/db/db.go:1\n"},
+		{name: "short", format: loggo.CallerFormatShort, file: "/home/user/app/db/db.go", want: "db/db.go:1\n"},
+		{name: "short, single element", format: loggo.CallerFormatShort, file: "db.go", want: "db.go:1\n"},
+		{name: "short, two elements", format: loggo.CallerFormatShort, file: "db/db.go", want: "db/db.go:1\n"},
+		{name: "base", format: loggo.CallerFormatBase, file: "/home/user/app/db/db.go", want: "db.go:1\n"},
+		{name: "base, single element", format: loggo.CallerFormatBase, file: "db.go", want: "db.go:1\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			provider := func() (pc uintptr, file string, line int, ok bool) {
+				return 0, tc.file, 1, true
+			}
+
+			logger := loggo.New(
+				loggo.LevelInfo,
+				loggo.WithOutput(w),
+				loggo.WithTemplate("{{.Caller}}"),
+				loggo.WithCallerProvider(provider),
+				loggo.WithCallerFormat(tc.format),
+			)
+			logger.Info("This is an info log message")
+
+			if w.String() != tc.want {
+				t.Errorf("Logger.Info() caller = %q, want %q", w.String(), tc.want)
+			}
+		})
+	}
+}
+
+type service struct {
+	logger *loggo.Logger
+}
+
+func (s *service) run() {
+	s.logger.Info("This is an info log message")
+}
+
+func TestLogger_Log_function(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Function}}"))
+
+	logger.Info("This is an info log message")
+	(&service{logger: logger}).run()
+
+	if want := "loggo_test.TestLogger_Log_function\nloggo_test.(*service).run\n"; w.String() != want {
+		t.Errorf("Logger.Info() function = %q, want %q", w.String(), want)
+	}
+}
+
+func ExampleLogger_Log_functionUnknown() {
+	logger := loggo.New(
+		loggo.LevelInfo,
+		loggo.WithTimeProvider(fakeNow),
+		loggo.WithTemplate("{{.Function}} [{{.Level}}]: {{.Message}}"),
+		loggo.WithCallerProvider(okCallerProvider),
+	)
+	logger.Log(loggo.LevelInfo, "This is an info log message")
+	// Output: unknown [INFO]: This is an info log message
+}
+
+func TestLogger_Log_component(t *testing.T) {
+	type testCase struct {
+		name   string
+		prefix string
+		caller loggo.CallerProvider
+		want   string
+	}
+
+	testCases := []testCase{
+		{name: "no prefix", prefix: "github.com/hvpaiva", want: "loggo_test\n"},
+		{name: "partial element prefix", prefix: "github.com/hvpaiva/loggo", want: "github.com/hvpaiva/loggo_test\n"},
+		{name: "package prefix", prefix: "github.com/hvpaiva/loggo_test", want: "loggo_test\n"},
+		{name: "main module prefix", prefix: "", want: "github.com/hvpaiva/loggo_test\n"},
+		{name: "other prefix", prefix: "github.com/acme", want: "github.com/hvpaiva/loggo_test\n"},
+		{name: "unknown caller", prefix: "github.com/acme", caller: errorCallerProvider, want: "unknown\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			options := []loggo.Option{loggo.WithOutput(w), loggo.WithTemplate("{{.Component}}"), loggo.WithComponent(tc.prefix)}
+
+			if tc.caller != nil {
+				options = append(options, loggo.WithCallerProvider(tc.caller))
+			}
+
+			logger := loggo.New(loggo.LevelInfo, options...)
+			logger.Info("This is an info log message")
+
+			if w.String() != tc.want {
+				t.Errorf("Logger.Info() component = %q, want %q", w.String(), tc.want)
+			}
+		})
+	}
+}
+
+func ExampleLogger_Log_service() {
+	logger := loggo.New(
+		loggo.LevelInfo,
+		loggo.WithTimeProvider(fakeNow),
+		loggo.WithService("checkout", "1.4.2", "production"),
+		loggo.WithTemplate("{{.Service.Name}}@{{.Service.Version}} ({{.Service.Environment}}) [{{.Level}}]: {{.Message}}"),
+	)
+	logger.Log(loggo.LevelInfo, "This is an info log message")
+	// Output: checkout@1.4.2 (production) [INFO]: This is an info log message
+}
+
+func TestLogger_Log_buildInfo(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithBuildInfo(), loggo.WithTemplate("{{.Build.GoVersion}} {{.Build.Dirty}}"))
+	logger.Info("This is an info log message")
+
+	if want := runtime.Version() + " false\n"; w.String() != want {
+		t.Errorf("Logger.Info() build = %q, want %q", w.String(), want)
+	}
+}
+
+func ExampleLogger_Log_suffix() {
+	logger := loggo.New(loggo.LevelInfo, loggo.WithTimeProvider(fakeNow), loggo.WithCallerProvider(okCallerProvider), loggo.WithSuffix(" ({{.Caller}})"))
+	logger.Log(loggo.LevelInfo, "This is an info log message")
+	// Output: 2022-01-25 00:00:00 [ INFO]: This is an info log message (file:1)
+}
+
+func TestLogger_Log_lineEnding(t *testing.T) {
+	type testCase struct {
+		name   string
+		ending loggo.LineEnding
+		want   string
+	}
+
+	testCases := []testCase{
+		{name: "lf", ending: loggo.LineEndingLF, want: "first\nsecond\n"},
+		{name: "crlf", ending: loggo.LineEndingCRLF, want: "first\r\nsecond\r\n"},
+		{name: "none", ending: loggo.LineEndingNone, want: "firstsecond"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Message}}"), loggo.WithLineEnding(tc.ending))
+			logger.Info("first")
+			logger.Info("second")
+
+			if w.String() != tc.want {
+				t.Errorf("Logger.Info() = %q, want %q", w.String(), tc.want)
+			}
+		})
+	}
+}
+
+type errorWriter struct{}
+
+func (errorWriter) Write([]byte) (int, error) { return 0, errors.New("write failure") }
+
+func TestLogger_LogE_writeError(t *testing.T) {
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(errorWriter{}))
+	err := logger.LogE(loggo.LevelInfo, "This is an info log message")
+
+	if want := "error writing log: write failure"; err == nil || err.Error() != want {
+		t.Errorf("Logger.LogE() error = %v, want %q", err, want)
+	}
+}
+
+func TestWithErrorOutput(t *testing.T) {
+	type testCase struct {
+		name string
+		log  func(logger *loggo.Logger)
+		want string
+	}
+
+	testCases := []testCase{
+		{
+			name: "write failure",
+			log:  func(logger *loggo.Logger) { logger.Info("lost") },
+			want: "loggo: error writing log: write failure\n",
+		},
+		{
+			name: "template failure",
+			log:  func(logger *loggo.Logger) { logger.WithTemplateOnce("{{.Message").Info("lost") },
+			want: "loggo: error parsing template: template: log:1: unclosed action\n",
+		},
+		{
+			name: "batch failure",
+			log: func(logger *loggo.Logger) {
+				batch := logger.Batch()
+				batch.Info("lost")
+				_ = batch.Flush()
+			},
+			want: "loggo: error writing log: write failure\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errOut := &strings.Builder{}
+			logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(errorWriter{}), loggo.WithErrorOutput(errOut))
+
+			tc.log(logger)
+
+			if errOut.String() != tc.want {
+				t.Errorf("error output = %q, want %q", errOut.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestLogger_LogAt(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTimeProvider(fakeNow), loggo.WithTemplate("{{.Time}} {{.Message}} {{.Caller}}"), loggo.WithCallerFormat(loggo.CallerFormatBase))
+	at := time.Date(2020, 5, 17, 10, 30, 0, 0, time.UTC)
+
+	_, _, line, _ := runtime.Caller(0)
+	logger.LogAt(at, loggo.LevelInfo, "historical")
+	_ = logger.LogAtE(at.Add(time.Hour), loggo.LevelInfo, "historical error")
+	logger.Info("current")
+
+	want := fmt.Sprintf("2020-05-17 10:30:00 historical logger_test.go:%d\n2020-05-17 11:30:00 historical error logger_test.go:%d\n2022-01-25 00:00:00 current logger_test.go:%d\n", line+1, line+2, line+3)
+	if w.String() != want {
+		t.Errorf("Logger.LogAt() = %q, want %q", w.String(), want)
+	}
+}
+
+func TestWithFieldsFromFunc(t *testing.T) {
+	w := &strings.Builder{}
+	calls := 0
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Message}} {{.Fields}}"),
+		loggo.WithFieldsFromFunc(func() loggo.Fields {
+			calls++
+			return loggo.Fields{loggo.F("calls", calls)}
+		}))
+	child := logger.Child(loggo.WithFieldsFromFunc(func() loggo.Fields { return loggo.Fields{loggo.F("child", true)} }))
+
+	logger.Debug("discarded")
+	logger.Info("first")
+	child.Info("second")
+	logger.Info("third")
+
+	if want := "first calls=1\nsecond calls=2 child=true\nthird calls=3\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
+
+func TestWithContextFields(t *testing.T) {
+	type tenantKey struct{}
+
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Message}} {{.Fields}}"),
+		loggo.WithContextFields(func(ctx context.Context) loggo.Fields {
+			if tenant, ok := ctx.Value(tenantKey{}).(string); ok {
+				return loggo.Fields{loggo.F("tenant", tenant)}
+			}
+
+			return nil
+		}))
+
+	logger.Info("first")
+	logger.Child(loggo.WithContext(context.WithValue(context.Background(), tenantKey{}, "acme"))).Info("second")
+
+	if want := "first \nsecond tenant=acme\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
+
+func TestWithTimeBucket(t *testing.T) {
+	now := func() time.Time { return time.Date(2022, 1, 25, 10, 7, 42, 0, time.UTC) }
+
+	type testCase struct {
+		name    string
+		options []loggo.Option
+		want    string
+	}
+
+	testCases := []testCase{
+		{
+			name:    "template",
+			options: []loggo.Option{loggo.WithTimeBucket(loggo.TimeBucket{Window: 5 * time.Minute})},
+			want:    "[2022-01-25 10:05:00] started \n",
+		},
+		{
+			name: "field",
+			options: []loggo.Option{
+				loggo.WithTimeBucket(loggo.TimeBucket{Window: time.Minute, Field: "bucket"}),
+				loggo.WithTimeFormat(loggo.TimeFormatEpochSeconds),
+			},
+			want: "[1643105220] started bucket=1643105220\n",
+		},
+		{
+			name:    "disabled",
+			options: []loggo.Option{loggo.WithTimeBucket(loggo.TimeBucket{Field: "bucket"})},
+			want:    "[] started \n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			options := append([]loggo.Option{loggo.WithOutput(w), loggo.WithTimeProvider(now),
+				loggo.WithTemplate("[{{.TimeBucket}}] {{.Message}} {{.Fields}}")}, tc.options...)
+			logger := loggo.New(loggo.LevelInfo, options...)
+
+			logger.Info("started")
+
+			if w.String() != tc.want {
+				t.Errorf("output = %q, want %q", w.String(), tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package loggotest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+// AssertLogged asserts that at least one entry was recorded at the given level containing the given text.
+//
+// Parameters:
+//   - t: The test to report the failure to.
+//   - rec: The records to assert on.
+//   - level: The expected level of the entry.
+//   - contains: The text the entry is expected to contain.
+//
+// Returns:
+//   - true if the assertion passed, false otherwise.
+//
+// Example:
+//
+//	loggotest.AssertLogged(t, rec, loggo.LevelError, "timeout")
+func AssertLogged(t testing.TB, rec Records, level loggo.Level, contains string) bool {
+	t.Helper()
+
+	if len(matching(rec, level, contains)) > 0 {
+		return true
+	}
+
+	t.Errorf("expected a %s entry containing %q, but none was logged\n%s", level, contains, dump(rec))
+
+	return false
+}
+
+// AssertNotLogged asserts that no entry was recorded at the given level containing the given text.
+//
+// Parameters:
+//   - t: The test to report the failure to.
+//   - rec: The records to assert on.
+//   - level: The level of the unexpected entry.
+//   - contains: The text the unexpected entry would contain.
+//
+// Returns:
+//   - true if the assertion passed, false otherwise.
+//
+// Example:
+//
+//	loggotest.AssertNotLogged(t, rec, loggo.LevelError, "timeout")
+func AssertNotLogged(t testing.TB, rec Records, level loggo.Level, contains string) bool {
+	t.Helper()
+
+	found := matching(rec, level, contains)
+	if len(found) == 0 {
+		return true
+	}
+
+	t.Errorf("expected no %s entry containing %q, but found %d:\n\t%s\n%s", level, contains, len(found), strings.Join(found, "\n\t"), dump(rec))
+
+	return false
+}
+
+// AssertCount asserts that exactly n entries were recorded at the given level.
+//
+// Parameters:
+//   - t: The test to report the failure to.
+//   - rec: The records to assert on.
+//   - level: The level of the entries to count.
+//   - n: The expected number of entries.
+//
+// Returns:
+//   - true if the assertion passed, false otherwise.
+//
+// Example:
+//
+//	loggotest.AssertCount(t, rec, loggo.LevelWarn, 2)
+func AssertCount(t testing.TB, rec Records, level loggo.Level, n int) bool {
+	t.Helper()
+
+	got := len(rec.Logged(level))
+	if got == n {
+		return true
+	}
+
+	t.Errorf("expected %d %s entries, but got %d\n%s", n, level, got, dump(rec))
+
+	return false
+}
+
+// matching returns the entries at the given level containing the given text.
+func matching(rec Records, level loggo.Level, contains string) []string {
+	var found []string
+
+	for _, entry := range rec.Logged(level) {
+		if strings.Contains(entry, contains) {
+			found = append(found, entry)
+		}
+	}
+
+	return found
+}
+
+// dump renders all the recorded entries for a failure message.
+func dump(rec Records) string {
+	all := rec.All()
+	if len(all) == 0 {
+		return "no entries were logged"
+	}
+
+	return "logged entries:\n\t" + strings.Join(all, "\n\t")
+}
@@ -0,0 +1,141 @@
+package loggotest_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+type fakeT struct {
+	testing.TB
+	failure string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...any) {
+	t.failure = fmt.Sprintf(format, args...)
+}
+
+func TestAssertLogged(t *testing.T) {
+	rec := loggotest.NewRecorder()
+	logger, _ := loggotest.New(loggo.LevelInfo, loggo.WithOutput(rec))
+	logger.Error("connection timeout")
+
+	ft := &fakeT{}
+	if !loggotest.AssertLogged(ft, rec, loggo.LevelError, "timeout") {
+		t.Errorf("AssertLogged() failed: %s", ft.failure)
+	}
+
+	if loggotest.AssertLogged(ft, rec, loggo.LevelWarn, "timeout") {
+		t.Error("AssertLogged() passed for a missing entry")
+	}
+
+	want := "expected a WARN entry containing \"timeout\", but none was logged\nlogged entries:\n\t2022-01-25 00:00:00 [ERROR]: connection timeout"
+	if ft.failure != want {
+		t.Errorf("AssertLogged() failure = %q, want %q", ft.failure, want)
+	}
+}
+
+func TestAssertNotLogged(t *testing.T) {
+	rec := loggotest.NewRecorder()
+	logger, _ := loggotest.New(loggo.LevelInfo, loggo.WithOutput(rec))
+	logger.Error("connection timeout")
+
+	ft := &fakeT{}
+	if !loggotest.AssertNotLogged(ft, rec, loggo.LevelWarn, "timeout") {
+		t.Errorf("AssertNotLogged() failed: %s", ft.failure)
+	}
+
+	if loggotest.AssertNotLogged(ft, rec, loggo.LevelError, "timeout") {
+		t.Error("AssertNotLogged() passed for a logged entry")
+	}
+
+	want := "expected no ERROR entry containing \"timeout\", but found 1:\n\t2022-01-25 00:00:00 [ERROR]: connection timeout\nlogged entries:\n\t2022-01-25 00:00:00 [ERROR]: connection timeout"
+	if ft.failure != want {
+		t.Errorf("AssertNotLogged() failure = %q, want %q", ft.failure, want)
+	}
+}
+
+func TestAssertCount(t *testing.T) {
+	rec := loggotest.NewRecorder()
+
+	ft := &fakeT{}
+	if loggotest.AssertCount(ft, rec, loggo.LevelWarn, 1) {
+		t.Error("AssertCount() passed for an empty recorder")
+	}
+
+	if want := "expected 1 WARN entries, but got 0\nno entries were logged"; ft.failure != want {
+		t.Errorf("AssertCount() failure = %q, want %q", ft.failure, want)
+	}
+
+	logger, _ := loggotest.New(loggo.LevelInfo, loggo.WithOutput(rec))
+	logger.Warn("first")
+	logger.Info("second")
+	logger.Warn("third")
+
+	if !loggotest.AssertCount(ft, rec, loggo.LevelWarn, 2) {
+		t.Errorf("AssertCount() failed: %s", ft.failure)
+	}
+
+	rec.Reset()
+
+	if !loggotest.AssertCount(ft, rec, loggo.LevelWarn, 0) {
+		t.Errorf("AssertCount() failed after Reset: %s", ft.failure)
+	}
+}
+
+func TestRecorder_Logged(t *testing.T) {
+	type testCase struct {
+		name     string
+		template string
+		want     []string
+	}
+
+	testCases := []testCase{
+		{
+			name:     "default template",
+			template: "",
+			want:     []string{"2022-01-25 00:00:00 [ WARN]: disk at 95%, not an ERROR yet"},
+		},
+		{
+			name:     "logfmt",
+			template: "level={{.Level}} msg={{.Message}}",
+			want:     []string{"level=WARN msg=disk at 95%, not an ERROR yet"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := loggotest.NewRecorder()
+			options := []loggo.Option{loggo.WithOutput(rec), loggo.WithLineEnding(loggo.LineEndingCRLF)}
+			if tc.template != "" {
+				options = append(options, loggo.WithTemplate(tc.template))
+			}
+
+			logger, _ := loggotest.New(loggo.LevelInfo, options...)
+			logger.Warn("disk at 95%, not an ERROR yet")
+
+			if got := rec.Logged(loggo.LevelWarn); fmt.Sprint(got) != fmt.Sprint(tc.want) {
+				t.Errorf("Logged(WARN) = %q, want %q", got, tc.want)
+			}
+
+			if got := rec.Logged(loggo.LevelError); len(got) != 0 {
+				t.Errorf("Logged(ERROR) = %q, want none", got)
+			}
+		})
+	}
+}
+
+func ExampleRecorder() {
+	rec := loggotest.NewRecorder()
+	logger, _ := loggotest.New(loggo.LevelInfo, loggo.WithOutput(rec))
+
+	logger.Info("first")
+	logger.Warn("second")
+
+	fmt.Println(rec.Logged(loggo.LevelWarn))
+	// Output: [2022-01-25 00:00:00 [ WARN]: second]
+}
@@ -0,0 +1,166 @@
+package loggotest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hvpaiva/loggo"
+)
+
+// Start is the fixed time a Clock created by NewClock starts at.
+var Start = time.Date(2022, 1, 25, 0, 0, 0, 0, time.UTC)
+
+// Clock is a deterministic loggo.Clock. Its time only changes when Advance or Set is called, and its tickers only tick
+// when the time is moved past their deadlines.
+type Clock struct {
+	mu      sync.Mutex
+	now     time.Time
+	tickers []*ticker
+}
+
+// NewClock creates a new Clock starting at the fixed Start time.
+//
+// Returns:
+//   - A pointer to the newly created Clock.
+//
+// Example:
+//
+//	clock := loggotest.NewClock()
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithClock(clock))
+func NewClock() *Clock {
+	return NewClockAt(Start)
+}
+
+// NewClockAt creates a new Clock starting at the given time.
+//
+// Parameters:
+//   - start: The time the Clock starts at.
+//
+// Returns:
+//   - A pointer to the newly created Clock.
+func NewClockAt(start time.Time) *Clock {
+	return &Clock{now: start}
+}
+
+// New creates a new loggo.Logger wired to a new Clock starting at the fixed Start time.
+//
+// Parameters:
+//   - threshold: Minimum log level to output.
+//   - options: Variadic options to configure the Logger.
+//
+// Returns:
+//   - A pointer to the newly created Logger.
+//   - A pointer to the Clock used by the Logger.
+//
+// Example:
+//
+//	logger, clock := loggotest.New(loggo.LevelInfo, loggo.WithOutput(w))
+//	logger.Info("first")
+//	clock.Advance(time.Minute)
+//	logger.Info("one minute later")
+func New(threshold loggo.Level, options ...loggo.Option) (*loggo.Logger, *Clock) {
+	clock := NewClock()
+
+	return loggo.New(threshold, append([]loggo.Option{loggo.WithClock(clock)}, options...)...), clock
+}
+
+// Now returns the current time of the Clock.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Advance moves the Clock forward by the given duration, firing the tickers whose deadlines were reached.
+//
+// Parameters:
+//   - d: The duration to move the Clock forward by.
+func (c *Clock) Advance(d time.Duration) {
+	c.Set(c.Now().Add(d))
+}
+
+// Set moves the Clock to the given time, firing the tickers whose deadlines were reached.
+//
+// Parameters:
+//   - t: The new time of the Clock.
+func (c *Clock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = t
+
+	for _, tk := range c.tickers {
+		tk.fire(t)
+	}
+}
+
+// NewTicker returns a new loggo.Ticker that ticks every d of Clock time.
+//
+// Parameters:
+//   - d: The period of the ticker. It must be greater than zero.
+//
+// Returns:
+//   - The new ticker.
+func (c *Clock) NewTicker(d time.Duration) loggo.Ticker {
+	if d <= 0 {
+		panic("loggotest: non-positive interval for NewTicker")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	tk := &ticker{
+		clock:  c,
+		period: d,
+		next:   c.now.Add(d),
+		ch:     make(chan time.Time, 1),
+	}
+	c.tickers = append(c.tickers, tk)
+
+	return tk
+}
+
+// stop removes the ticker from the Clock.
+func (c *Clock) stop(tk *ticker) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, t := range c.tickers {
+		if t == tk {
+			c.tickers = append(c.tickers[:i], c.tickers[i+1:]...)
+
+			return
+		}
+	}
+}
+
+// ticker is a loggo.Ticker driven by a Clock.
+type ticker struct {
+	clock  *Clock
+	period time.Duration
+	next   time.Time
+	ch     chan time.Time
+}
+
+// C returns the channel on which the ticks are delivered.
+func (t *ticker) C() <-chan time.Time {
+	return t.ch
+}
+
+// Stop turns off the ticker.
+func (t *ticker) Stop() {
+	t.clock.stop(t)
+}
+
+// fire delivers the ticks up to now. Like time.Ticker, ticks are dropped if the receiver is not keeping up.
+func (t *ticker) fire(now time.Time) {
+	for !t.next.After(now) {
+		select {
+		case t.ch <- t.next:
+		default:
+		}
+
+		t.next = t.next.Add(t.period)
+	}
+}
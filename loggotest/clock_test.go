@@ -0,0 +1,77 @@
+package loggotest_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+func TestClock_NewTicker(t *testing.T) {
+	clock := loggotest.NewClock()
+	tk := clock.NewTicker(time.Second)
+
+	clock.Advance(500 * time.Millisecond)
+
+	select {
+	case <-tk.C():
+		t.Fatal("Ticker ticked before its deadline")
+	default:
+	}
+
+	clock.Advance(500 * time.Millisecond)
+
+	select {
+	case got := <-tk.C():
+		if want := loggotest.Start.Add(time.Second); !got.Equal(want) {
+			t.Errorf("Ticker tick = %v, want %v", got, want)
+		}
+	default:
+		t.Fatal("Ticker did not tick at its deadline")
+	}
+
+	tk.Stop()
+	clock.Advance(time.Second)
+
+	select {
+	case <-tk.C():
+		t.Fatal("Ticker ticked after being stopped")
+	default:
+	}
+}
+
+func TestClock_NewTicker_invalidPeriod(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Clock.NewTicker() did not panic")
+		}
+	}()
+
+	loggotest.NewClock().NewTicker(0)
+}
+
+func TestNew(t *testing.T) {
+	w := &strings.Builder{}
+	logger, clock := loggotest.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Time}}"))
+
+	logger.Info("first")
+	clock.Advance(time.Minute)
+	logger.Info("second")
+
+	want := "2022-01-25 00:00:00\n2022-01-25 00:01:00\n"
+	if w.String() != want {
+		t.Errorf("Logger.Info() = %q, want %q", w.String(), want)
+	}
+}
+
+func ExampleClock_Advance() {
+	logger, clock := loggotest.New(loggo.LevelInfo)
+
+	logger.Info("This is an info log message")
+	clock.Advance(90 * time.Second)
+	logger.Info("This is an info log message after 90 seconds")
+	// Output: 2022-01-25 00:00:00 [ INFO]: This is an info log message
+	// 2022-01-25 00:01:30 [ INFO]: This is an info log message after 90 seconds
+}
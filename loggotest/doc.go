@@ -0,0 +1,7 @@
+// Package loggotest provides utilities for testing code that uses the loggo package.
+//
+// It includes a deterministic Clock that can be advanced manually, so log timestamps and any periodic work done by a
+// Logger are fully controlled by the test, a Recorder that captures the lines written by a Logger, an Observer that
+// captures the structured entries logged, and assertion helpers such as AssertLogged, AssertNotLogged and AssertCount
+// that operate on both.
+package loggotest
@@ -0,0 +1,88 @@
+package loggotest
+
+import (
+	"sync"
+
+	"github.com/hvpaiva/loggo"
+)
+
+// Observer is a loggo.Sink that captures the structured entries logged, so tests can assert on their semantics instead
+// of their rendered text. It is safe for concurrent use.
+type Observer struct {
+	mu      sync.Mutex
+	entries []loggo.Entry
+}
+
+// NewObserver creates a new, empty Observer.
+//
+// Returns:
+//   - A pointer to the newly created Observer.
+//
+// Example:
+//
+//	observer := loggotest.NewObserver()
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithSink(observer))
+func NewObserver() *Observer {
+	return &Observer{}
+}
+
+// WriteEntry captures the entry.
+func (o *Observer) WriteEntry(entry loggo.Entry) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.entries = append(o.entries, entry)
+
+	return nil
+}
+
+// Entries returns all the captured entries, in the order they were logged.
+func (o *Observer) Entries() []loggo.Entry {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return append([]loggo.Entry(nil), o.entries...)
+}
+
+// Filter returns the captured entries at the given level.
+func (o *Observer) Filter(level loggo.Level) []loggo.Entry {
+	var entries []loggo.Entry
+
+	for _, entry := range o.Entries() {
+		if entry.Level == level {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+// Logged returns the messages of the captured entries at the given level.
+func (o *Observer) Logged(level loggo.Level) []string {
+	var messages []string
+
+	for _, entry := range o.Filter(level) {
+		messages = append(messages, entry.Message)
+	}
+
+	return messages
+}
+
+// All returns all the captured entries, rendered as "[LEVEL] message".
+func (o *Observer) All() []string {
+	var all []string
+
+	for _, entry := range o.Entries() {
+		all = append(all, "["+entry.Level.String()+"] "+entry.Message)
+	}
+
+	return all
+}
+
+// Reset discards all the captured entries.
+func (o *Observer) Reset() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.entries = nil
+}
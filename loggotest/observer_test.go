@@ -0,0 +1,57 @@
+package loggotest_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+func TestObserver(t *testing.T) {
+	observer := loggotest.NewObserver()
+	logger, _ := loggotest.New(loggo.LevelInfo, loggo.WithOutput(io.Discard), loggo.WithSink(observer))
+
+	logger.Debug("discarded")
+	logger.Info("first")
+	logger.Error("connection timeout")
+
+	entries := observer.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("Observer.Entries() = %d entries, want 2", len(entries))
+	}
+
+	want := loggo.Entry{Level: loggo.LevelError, Time: loggotest.Start, Message: "connection timeout", Caller: entries[1].Caller, Function: "loggotest_test.TestObserver", Context: context.Background()}
+	if !reflect.DeepEqual(entries[1], want) {
+		t.Errorf("Observer.Entries()[1] = %+v, want %+v", entries[1], want)
+	}
+
+	loggotest.AssertLogged(t, observer, loggo.LevelError, "timeout")
+	loggotest.AssertNotLogged(t, observer, loggo.LevelDebug, "")
+	loggotest.AssertCount(t, observer, loggo.LevelInfo, 1)
+
+	if got, want := fmt.Sprint(observer.All()), "[[INFO] first [ERROR] connection timeout]"; got != want {
+		t.Errorf("Observer.All() = %s, want %s", got, want)
+	}
+
+	observer.Reset()
+
+	if got := observer.Entries(); len(got) != 0 {
+		t.Errorf("Observer.Entries() after Reset = %v, want none", got)
+	}
+}
+
+func ExampleObserver() {
+	observer := loggotest.NewObserver()
+	logger, _ := loggotest.New(loggo.LevelInfo, loggo.WithOutput(io.Discard), loggo.WithSink(observer))
+
+	logger.Warn("disk almost full")
+
+	for _, entry := range observer.Entries() {
+		fmt.Println(entry.Level, entry.Time, entry.Message)
+	}
+	// Output: WARN 2022-01-25 00:00:00 +0000 UTC disk almost full
+}
@@ -0,0 +1,105 @@
+package loggotest
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/hvpaiva/loggo"
+)
+
+// Records is implemented by the recorders the assertion helpers operate on.
+type Records interface {
+	// Logged returns the recorded entries at the given level, in the order they were logged.
+	Logged(level loggo.Level) []string
+	// All returns all the recorded entries, in the order they were logged.
+	All() []string
+}
+
+// Recorder is an io.Writer that records the lines written by a Logger. It is safe for concurrent use.
+//
+// The level of a line is the first of its words naming a level in upper case, e.g. WARN in "[ WARN]: disk full", so
+// the template of the Logger must render {{.Level}} before the message, as the default template does.
+type Recorder struct {
+	mu      sync.Mutex
+	lines   []string
+	partial string
+}
+
+// NewRecorder creates a new, empty Recorder.
+//
+// Returns:
+//   - A pointer to the newly created Recorder.
+//
+// Example:
+//
+//	rec := loggotest.NewRecorder()
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(rec))
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Write records the lines in p, without their LF or CRLF line ending. Incomplete lines are kept until their newline is
+// written.
+func (r *Recorder) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	lines := strings.Split(r.partial+string(p), "\n")
+	r.partial = lines[len(lines)-1]
+
+	for _, line := range lines[:len(lines)-1] {
+		r.lines = append(r.lines, strings.TrimSuffix(line, "\r"))
+	}
+
+	return len(p), nil
+}
+
+// All returns all the recorded lines, without their line ending.
+func (r *Recorder) All() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]string(nil), r.lines...)
+}
+
+// Logged returns the recorded lines at the given level.
+func (r *Recorder) Logged(level loggo.Level) []string {
+	var lines []string
+
+	for _, line := range r.All() {
+		if lineLevel, ok := levelOf(line); ok && lineLevel == level {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines
+}
+
+// levelOf returns the level of the line, the first of its words naming a level in upper case, and whether it has one.
+func levelOf(line string) (loggo.Level, bool) {
+	words := strings.FieldsFunc(line, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '(' && r != ')'
+	})
+
+	for _, word := range words {
+		if word != strings.ToUpper(word) {
+			continue
+		}
+
+		if level, err := loggo.ParseLevel(word); err == nil {
+			return level, true
+		}
+	}
+
+	return 0, false
+}
+
+// Reset discards all the recorded lines.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lines = nil
+	r.partial = ""
+}
@@ -0,0 +1,170 @@
+package loggo
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// scopeSeparator separates the scopes of the diagnostic context in {{.Scope}}.
+const scopeSeparator = ">"
+
+// diagnostics is the diagnostic context of a goroutine: the fields and scopes it pushed.
+type diagnostics struct {
+	fields Fields
+	scopes []string
+}
+
+// mdc holds the diagnostic context of each goroutine, keyed by goroutine ID.
+var mdc = struct {
+	sync.Mutex
+	active   atomic.Int64
+	contexts map[uint64]diagnostics
+}{contexts: map[uint64]diagnostics{}}
+
+// PushFields attaches the fields to every entry logged from the calling goroutine, by any Logger, until the returned
+// release function is called. It is a mapped diagnostic context (MDC) for code paths where threading a logger or a
+// context is impractical; prefer passing them when possible, as the fields do not follow the work to other goroutines.
+//
+// Scopes nest: the fields of inner scopes are added after the fields of outer ones, and releasing a scope removes the
+// fields pushed with it, and the ones of the scopes nested in it.
+//
+// Parameters:
+//   - fields: The fields to attach.
+//
+// Returns:
+//   - The function releasing the fields. It may be called more than once.
+//
+// Example:
+//
+//	release := loggo.PushFields(loggo.F("job", job.ID))
+//	defer release()
+//
+//	logger.Info("Starting job") // 2024-09-03 15:04:05 [ INFO]: Starting job job=42
+func PushFields(fields ...Field) (release func()) {
+	return push(func(d *diagnostics) func(*diagnostics) {
+		depth := len(d.fields)
+		d.fields = append(d.fields[:depth:depth], fields...)
+
+		return func(d *diagnostics) {
+			if len(d.fields) > depth {
+				d.fields = d.fields[:depth]
+			}
+		}
+	})
+}
+
+// PushScope pushes a named scope on the diagnostic context of the calling goroutine, until the returned release
+// function pops it. The stack of scopes is rendered by {{.Scope}}, e.g. "request>retry#2>db", to trace deep retry and
+// fallback flows in text logs. Releasing a scope also pops the scopes nested in it.
+//
+// Parameters:
+//   - name: The name of the scope.
+//
+// Returns:
+//   - The function popping the scope. It may be called more than once.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithTemplate("{{.Time}} [{{.Scope}}] {{.Message}}"))
+//
+//	for attempt := 1; ; attempt++ {
+//		pop := loggo.PushScope(fmt.Sprintf("retry#%d", attempt))
+//		err := query()
+//		pop()
+//	}
+func PushScope(name string) (release func()) {
+	return push(func(d *diagnostics) func(*diagnostics) {
+		depth := len(d.scopes)
+		d.scopes = append(d.scopes[:depth:depth], name)
+
+		return func(d *diagnostics) {
+			if len(d.scopes) > depth {
+				d.scopes = d.scopes[:depth]
+			}
+		}
+	})
+}
+
+// push modifies the diagnostic context of the calling goroutine, returning the function releasing the modification.
+func push(modify func(d *diagnostics) (undo func(d *diagnostics))) (release func()) {
+	id := goroutineID()
+
+	mdc.Lock()
+	d := mdc.contexts[id]
+	undo := modify(&d)
+	mdc.contexts[id] = d
+	mdc.Unlock()
+	mdc.active.Add(1)
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			mdc.Lock()
+			defer mdc.Unlock()
+
+			d := mdc.contexts[id]
+			undo(&d)
+
+			if len(d.fields) == 0 && len(d.scopes) == 0 {
+				delete(mdc.contexts, id)
+			} else {
+				mdc.contexts[id] = d
+			}
+
+			mdc.active.Add(-1)
+		})
+	}
+}
+
+// currentDiagnostics returns the fields and the rendered scope of the diagnostic context of the calling goroutine.
+func currentDiagnostics() (Fields, string) {
+	if mdc.active.Load() == 0 {
+		return nil, ""
+	}
+
+	id := goroutineID()
+
+	mdc.Lock()
+	defer mdc.Unlock()
+
+	d := mdc.contexts[id]
+
+	return d.fields[:len(d.fields):len(d.fields)], strings.Join(d.scopes, scopeSeparator)
+}
+
+// snapshotDiagnostics returns a copy of the fields and scopes of the diagnostic context of the calling goroutine.
+func snapshotDiagnostics() (Fields, []string) {
+	if mdc.active.Load() == 0 {
+		return nil, nil
+	}
+
+	id := goroutineID()
+
+	mdc.Lock()
+	defer mdc.Unlock()
+
+	d := mdc.contexts[id]
+
+	return append(Fields(nil), d.fields...), append([]string(nil), d.scopes...)
+}
+
+// goroutineID returns the ID of the calling goroutine, parsed from the header of its stack trace, "goroutine 42 [".
+func goroutineID() uint64 {
+	var buf [64]byte
+
+	header := buf[:runtime.Stack(buf[:], false)]
+	header = bytes.TrimPrefix(header, []byte("goroutine "))
+
+	if i := bytes.IndexByte(header, ' '); i >= 0 {
+		header = header[:i]
+	}
+
+	id, _ := strconv.ParseUint(string(header), 10, 64)
+
+	return id
+}
@@ -0,0 +1,102 @@
+package loggo_test
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestPushFields(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTimeProvider(fakeNow))
+
+	logger.Info("before")
+
+	release := loggo.PushFields(loggo.F("job", 42))
+	logger.Info("outer")
+
+	releaseInner := loggo.PushFields(loggo.F("step", "load data"))
+	logger.Info("inner")
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		logger.Info("other goroutine")
+	}()
+
+	wg.Wait()
+
+	releaseInner()
+	releaseInner()
+	logger.Info("released inner")
+
+	release()
+	logger.Info("after")
+
+	want := "2022-01-25 00:00:00 [ INFO]: before\n" +
+		"2022-01-25 00:00:00 [ INFO]: outer job=42\n" +
+		"2022-01-25 00:00:00 [ INFO]: inner job=42 step=\"load data\"\n" +
+		"2022-01-25 00:00:00 [ INFO]: other goroutine\n" +
+		"2022-01-25 00:00:00 [ INFO]: released inner job=42\n" +
+		"2022-01-25 00:00:00 [ INFO]: after\n"
+
+	if w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
+
+func TestFields(t *testing.T) {
+	fields := loggo.Fields{loggo.F("user", 42), loggo.F("path", "/a b"), loggo.F("empty", ""), loggo.F("user", 7)}
+
+	if got, want := fields.String(), `user=42 path="/a b" empty="" user=7`; got != want {
+		t.Errorf("Fields.String() = %s, want %s", got, want)
+	}
+
+	if v, ok := fields.Get("user"); !ok || v != 7 {
+		t.Errorf("Fields.Get() = %v, %v, want 7, true", v, ok)
+	}
+
+	if _, ok := fields.Get("missing"); ok {
+		t.Error("Fields.Get() found a missing key")
+	}
+}
+
+func TestPushScope(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("[{{.Scope}}] {{.Message}}{{with .Fields}} {{.}}{{end}}"))
+
+	popRequest := loggo.PushScope("request")
+	release := loggo.PushFields(loggo.F("id", 7))
+
+	for attempt := 1; attempt <= 2; attempt++ {
+		popRetry := loggo.PushScope(fmt.Sprintf("retry#%d", attempt))
+		popDB := loggo.PushScope("db")
+		logger.Info("query")
+		popDB()
+		popRetry()
+	}
+
+	release()
+	logger.Info("done")
+
+	// Popping the outer scope also pops the nested ones.
+	loggo.PushScope("nested")
+	popRequest()
+	logger.Info("outside")
+
+	want := "[request>retry#1>db] query id=7\n" +
+		"[request>retry#2>db] query id=7\n" +
+		"[request] done\n" +
+		"[] outside\n"
+
+	if w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
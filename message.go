@@ -0,0 +1,62 @@
+package loggo
+
+import (
+	"fmt"
+)
+
+// message is the message of a log call. Formatted messages are only formatted once the entry is known to be needed,
+// so their arguments, including fmt.Stringer and error values, are not evaluated for discarded entries.
+type message struct {
+	format string
+	args   []any
+	lazy   bool
+}
+
+// text returns a message with the given text.
+func text(s string) message {
+	return message{format: s}
+}
+
+// sprintf returns a message formatted with fmt.Sprintf when needed.
+func sprintf(format string, args []any) message {
+	return message{format: format, args: args, lazy: true}
+}
+
+// String returns the text of the message, formatting it if needed.
+func (m message) String() string {
+	if m.lazy {
+		return fmt.Sprintf(m.format, m.args...)
+	}
+
+	return m.format
+}
+
+// Lazy returns a fmt.Stringer calling the function only when the message it is an argument of is formatted, i.e. when
+// the entry is not discarded, to defer expensive computations.
+//
+// Parameters:
+//   - fn: The function computing the value.
+//
+// Returns:
+//   - A fmt.Stringer formatting the value returned by the function.
+//
+// Example:
+//
+//	logger.Debugf("state: %s", loggo.Lazy(func() any { return dumpState() }))
+func Lazy(fn func() any) fmt.Stringer {
+	return lazy(fn)
+}
+
+// lazy is a fmt.Stringer calling a function when formatted.
+type lazy func() any
+
+// String returns the value returned by the function, formatted with fmt.Sprint.
+func (l lazy) String() string {
+	return fmt.Sprint(l())
+}
+
+// discards reports whether a message at the level would be discarded by the Threshold, regardless of its text.
+// It is only known when the logger has no pre-hooks, as they run before the Threshold is checked.
+func (l *Logger) discards(level Level) bool {
+	return len(l.preHooks) == 0 && l.escalate(l.Context, level).Rank() < l.GetThreshold().Rank()
+}
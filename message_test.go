@@ -0,0 +1,80 @@
+package loggo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+type countingStringer struct {
+	calls int
+}
+
+func (s *countingStringer) String() string {
+	s.calls++
+
+	return "value"
+}
+
+func TestLogger_DeferredFormatting(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Message}}"))
+	arg := &countingStringer{}
+
+	logger.Debugf("debug: %s", arg)
+
+	batch := logger.Batch()
+	batch.Debugf("debug: %s", arg)
+	_ = batch.Flush()
+
+	if arg.calls != 0 {
+		t.Errorf("String() called %d times for discarded entries, want 0", arg.calls)
+	}
+
+	logger.Infof("info: %s", arg)
+
+	if arg.calls != 1 {
+		t.Errorf("String() called %d times for a logged entry, want 1", arg.calls)
+	}
+
+	if want := "info: value\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
+
+func TestLogger_DeferredFormattingWithPreHook(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Message}}"),
+		loggo.WithPreHook(func(_ *loggo.Logger, message *string) {
+			*message = strings.ToUpper(*message)
+		}))
+
+	logger.Infof("info: %s", &countingStringer{})
+
+	if want := "INFO: VALUE\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
+
+func TestLazy(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Message}}"))
+	var calls int
+	state := loggo.Lazy(func() any {
+		calls++
+
+		return 42
+	})
+
+	logger.Debugf("state: %s", state)
+	logger.Infof("state: %s", state)
+
+	if calls != 1 {
+		t.Errorf("function called %d times, want 1", calls)
+	}
+
+	if want := "state: 42\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
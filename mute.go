@@ -0,0 +1,30 @@
+package loggo
+
+// Mute disables all output of the Logger, and of the loggers sharing its output, while retaining their
+// configuration, e.g. during interactive phases of a CLI where log lines would corrupt the terminal rendering.
+// The messages logged while muted are discarded, without running the hooks. It is safe for concurrent use.
+//
+// Example:
+//
+//	logger.Mute()
+//	runInteractivePrompt()
+//	logger.Unmute()
+func (l *Logger) Mute() {
+	l.muted.Store(true)
+}
+
+// Unmute enables the output of the Logger, and of the loggers sharing its output, disabled by Mute.
+// It is safe for concurrent use.
+func (l *Logger) Unmute() {
+	l.muted.Store(false)
+}
+
+// Muted reports whether the output of the Logger is disabled by Mute.
+func (l *Logger) Muted() bool {
+	return l.muted.Load()
+}
+
+// off reports whether the logger discards every message, either disabled or muted.
+func (l *Logger) off() bool {
+	return l.disabled || l.muted.Load()
+}
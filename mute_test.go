@@ -0,0 +1,43 @@
+package loggo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestLogger_Mute(t *testing.T) {
+	w := &strings.Builder{}
+	var hooked int
+
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Message}}"), loggo.WithPostHook(func(*loggo.Logger, *string) {
+		hooked++
+	}))
+	child := logger.Child()
+
+	logger.Info("first")
+	logger.Mute()
+
+	if !child.Muted() {
+		t.Error("Logger.Muted() of the child = false, want true")
+	}
+
+	logger.Info("muted")
+	child.Info("muted child")
+
+	batch := logger.Batch()
+	batch.Info("muted batch")
+	_ = batch.Flush()
+
+	child.Unmute()
+	logger.Info("second")
+
+	if want := "first\nsecond\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+
+	if hooked != 2 {
+		t.Errorf("post-hooks ran %d times, want 2", hooked)
+	}
+}
@@ -3,6 +3,7 @@ package loggo
 import (
 	"context"
 	"io"
+	"os"
 	"time"
 )
 
@@ -19,6 +20,9 @@ type CallerProvider func() (pc uintptr, file string, line int, ok bool)
 // Hook is a function that is executed before or after logging a message.
 type Hook func(l *Logger, message *string)
 
+// Filter is a function that decides whether an entry is logged. Entries for which it returns false are discarded.
+type Filter func(entry Entry) bool
+
 // WithOutput configures the output destination of a Logger. The default output is os.Stdout.
 //
 // Parameters:
@@ -30,11 +34,13 @@ type Hook func(l *Logger, message *string)
 func WithOutput(output io.Writer) Option {
 	return func(l *Logger) {
 		l.output = output
+		l.ownsOutput = true
 	}
 }
 
 // WithTemplate configures the log message template of a Logger. The default template is
-// "{{.Time}} [{{printf \"%5s\" .Level}}]: {{.Message}}".
+// "{{.Time}} [{{.Color}}{{printf \"%5s\" .Level}}{{.Reset}}]: {{.Message}}{{with .Fields}} {{.}}{{end}}", rendering
+// the level colored if colors are enabled with WithColor, and the fields of the entry, if any, after the message.
 //
 // Parameters:
 //   - template: The template string for log messages.
@@ -48,7 +54,39 @@ func WithTemplate(template string) Option {
 	}
 }
 
+// WithSuffix configures a suffix appended to every log line of a Logger, after the template and before the line
+// ending. The suffix is itself a template, so it can be a static trailer or render any template field, which is
+// useful for formats that put metadata at the end of the line. By default, there is no suffix.
+//
+// Parameters:
+//   - suffix: The suffix template.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithSuffix(" ({{.Caller}})"))
+func WithSuffix(suffix string) Option {
+	return func(l *Logger) {
+		l.suffix = suffix
+	}
+}
+
+// WithLineEnding configures the line ending appended to every log line of a Logger. The default line ending is
+// LineEndingLF. Use LineEndingCRLF for Windows tools, or LineEndingNone when the output dictates its own framing.
+//
+// Parameters:
+//   - ending: The LineEnding to use.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithLineEnding(loggo.LineEndingCRLF))
+func WithLineEnding(ending LineEnding) Option {
+	return func(l *Logger) {
+		l.lineEnding = ending
+	}
+}
+
 // WithTimeProvider configures the time provider function of a Logger. The default time provider is time.Now.
+// It is a shorthand for WithClock with a Clock whose tickers are the ones from the time package.
 //
 // Parameters:
 //   - provider: The TimeProvider function to use.
@@ -58,24 +96,66 @@ func WithTemplate(template string) Option {
 //	logger := loggo.New(loggo.LevelInfo, loggo.WithTimeProvider(func() time.Time { return time.Unix(0, 0) }))
 func WithTimeProvider(provider TimeProvider) Option {
 	return func(l *Logger) {
-		l.now = provider
+		l.clock = systemClock{now: provider}
+	}
+}
+
+// WithClock configures the clock of a Logger, used for the log timestamps and for any periodic work done by the Logger.
+// The default clock is based on time.Now and the time package tickers.
+//
+// Parameters:
+//   - clock: The Clock to use.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithClock(myClock))
+func WithClock(clock Clock) Option {
+	return func(l *Logger) {
+		l.clock = clock
 	}
 }
 
 // WithTimeFormat configures the time format of a Logger. The default time format is "2006-01-02 15:04:05".
+// Any time layout accepted by time.Time.Format can be used, as well as the TimeFormat presets, including the numeric
+// epoch ones.
 //
 // Parameters:
 //   - format: The format string for the time in the log message.
 //
 // Example:
 //
-//	logger := loggo.New(loggo.LevelInfo, loggo.WithTimeFormat("2006-01-02 15:04:05"))
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithTimeFormat(loggo.TimeFormatRFC3339Nano))
 func WithTimeFormat(format string) Option {
 	return func(l *Logger) {
 		l.timeFormat = format
 	}
 }
 
+// WithLocation configures the time zone used to render the time of a Logger. By default, the time is rendered in the
+// location returned by the time provider.
+//
+// Parameters:
+//   - loc: The location to render the time in.
+//
+// Example:
+//
+//	loc, _ := time.LoadLocation("America/Sao_Paulo")
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithLocation(loc))
+func WithLocation(loc *time.Location) Option {
+	return func(l *Logger) {
+		l.location = loc
+	}
+}
+
+// WithUTC configures a Logger to render the time in UTC, regardless of the server locale.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithUTC())
+func WithUTC() Option {
+	return WithLocation(time.UTC)
+}
+
 // WithMaxSize configures the maximum size of a log message. The default maximum size is 1000.
 //
 // Parameters:
@@ -90,6 +170,37 @@ func WithMaxSize(size int) Option {
 	}
 }
 
+// WithMaxFieldSize configures the maximum size of the value of a field, rendered with fmt.Sprint, independently of
+// the maximum size of the message. Longer values are truncated, followed by "...(truncated)", protecting the outputs
+// and collectors from a single huge field. There is no limit by default.
+//
+// Parameters:
+//   - size: The maximum size of the value of a field.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithMaxFieldSize(256))
+func WithMaxFieldSize(size int) Option {
+	return func(l *Logger) {
+		l.maxFieldSize = size
+	}
+}
+
+// WithMaxFields configures the maximum number of fields of an entry. The fields beyond it are dropped, and replaced by
+// a "fields_dropped" field with their number. There is no limit by default.
+//
+// Parameters:
+//   - count: The maximum number of fields of an entry.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithMaxFields(32))
+func WithMaxFields(count int) Option {
+	return func(l *Logger) {
+		l.maxFields = count
+	}
+}
+
 // WithCallerProvider configures the caller provider function of a Logger. The default caller provider is runtime.Caller.
 //
 // Parameters:
@@ -106,6 +217,125 @@ func WithCallerProvider(provider CallerProvider) Option {
 	}
 }
 
+// WithCallerSkip configures the number of additional stack frames skipped by the default caller provider of a Logger.
+// It is useful when the Logger is wrapped by helper functions, so {{.Caller}} reports the call site of the helper
+// instead of the helper itself. The default is 0. It has no effect on a custom caller provider.
+//
+// Parameters:
+//   - skip: The number of additional stack frames to skip.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithCallerSkip(1))
+//
+//	func logInfo(message string) {
+//		logger.Info(message) // {{.Caller}} reports the caller of logInfo
+//	}
+func WithCallerSkip(skip int) Option {
+	return func(l *Logger) {
+		l.callerSkip = skip
+	}
+}
+
+// WithCallerFormat configures how the file path of the caller is rendered. The default is CallerFormatFull.
+// Shorter formats avoid leaking build machine details and keep log lines compact.
+//
+// Parameters:
+//   - format: The CallerFormat to use.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithCallerFormat(loggo.CallerFormatShort))
+func WithCallerFormat(format CallerFormat) Option {
+	return func(l *Logger) {
+		l.callerFormat = format
+	}
+}
+
+// WithComponent enables the derivation of the component of each entry from the package path of its caller, exposed as
+// {{.Component}}. The prefix is trimmed from the package path, so with the prefix "github.com/acme/app", a log call in
+// the package "github.com/acme/app/internal/payments" has the component "internal/payments". When the prefix is
+// empty, the path of the main module of the running binary is trimmed.
+//
+// Parameters:
+//   - prefix: The prefix trimmed from the package path of the caller.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithComponent(""), loggo.WithTemplate("{{.Component}}: {{.Message}}"))
+func WithComponent(prefix string) Option {
+	return func(l *Logger) {
+		if prefix == "" {
+			prefix = mainModulePath()
+		}
+
+		l.component = true
+		l.componentPrefix = prefix
+	}
+}
+
+// WithService configures the service emitting the log entries of a Logger. Its values are available in the template
+// as {{.Service.Name}}, {{.Service.Version}} and {{.Service.Environment}}, and in the Service of each Entry.
+//
+// Parameters:
+//   - name: The name of the service.
+//   - version: The version of the service.
+//   - environment: The deployment environment of the service.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithService("checkout", "1.4.2", "production"))
+func WithService(name, version, environment string) Option {
+	return func(l *Logger) {
+		l.service = Service{Name: name, Version: version, Environment: environment}
+	}
+}
+
+// WithBuildInfo enables the build information of the running binary in the log entries of a Logger: the VCS revision,
+// whether the working tree was dirty, and the Go version. They are available in the template as {{.Build.Revision}},
+// {{.Build.Dirty}} and {{.Build.GoVersion}}, and in the Build of each Entry. The build information is read once, when
+// the option is applied.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithBuildInfo())
+func WithBuildInfo() Option {
+	return func(l *Logger) {
+		l.build = readBuild()
+	}
+}
+
+// WithEntryID enables a unique ID for each entry of a Logger, generated by the given IDGenerator, such as loggo.ULID or
+// loggo.UUID. The ID is available in the template as {{.ID}}, and in the ID of each Entry, so individual lines can be
+// referenced unambiguously and joined across outputs.
+//
+// Parameters:
+//   - generator: The IDGenerator to use.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithEntryID(loggo.ULID), loggo.WithSuffix(" log_id={{.ID}}"))
+func WithEntryID(generator IDGenerator) Option {
+	return func(l *Logger) {
+		l.idGenerator = generator
+	}
+}
+
+// WithChecksum appends a checksum of each line to it, before the line ending, e.g. " crc32=1a2b3c4d", allowing
+// downstream tooling to detect truncation or corruption with loggo.VerifyChecksum.
+//
+// Parameters:
+//   - checksum: The checksum algorithm, such as loggo.ChecksumCRC32.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithChecksum(loggo.ChecksumCRC32))
+func WithChecksum(checksum Checksum) Option {
+	return func(l *Logger) {
+		l.checksum = checksum
+	}
+}
+
 // WithContext configures the context of a Logger. The default context is context.Background.
 //
 // Parameters:
@@ -120,6 +350,46 @@ func WithContext(ctx context.Context) Option {
 	}
 }
 
+// WithContextKey maps a name to a key of the context values of a Logger, so templates can include the value with
+// {{ctx "name"}}. Names without a mapping are used as keys themselves.
+//
+// Parameters:
+//   - name: The name used in templates.
+//   - key: The key of the context value.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo,
+//		loggo.WithContext(ctx),
+//		loggo.WithContextKey("request_id", requestIDKey{}),
+//		loggo.WithTemplate(`{{.Time}} [{{ctx "request_id"}}] {{.Message}}`),
+//	)
+func WithContextKey(name string, key any) Option {
+	return func(l *Logger) {
+		keys := make(map[string]any, len(l.contextKeys)+1)
+		for n, k := range l.contextKeys {
+			keys[n] = k
+		}
+
+		keys[name] = key
+		l.contextKeys = keys
+	}
+}
+
+// WithDeadlineWarnings enables deadline warnings on a Logger, based on its context. While the context has a deadline,
+// the entries note the remaining time, e.g. "deadline_ms=120". Once the context is cancelled or expired, the entries
+// note the reason, e.g. "context=context canceled", and the messages below LevelWarn are escalated to LevelWarn, as
+// work still logging after its deadline usually deserves attention.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithContext(ctx), loggo.WithDeadlineWarnings())
+func WithDeadlineWarnings() Option {
+	return func(l *Logger) {
+		l.deadlineWarn = true
+	}
+}
+
 // WithPreHook adds a pre-hook to a Logger. Pre-hooks are executed before logging a message.
 //
 // Parameters:
@@ -151,3 +421,571 @@ func WithPostHook(hook Hook) Option {
 		l.postHooks = append(l.postHooks, hook)
 	}
 }
+
+// WithFilter adds a filter to a Logger. Filters run after the Threshold check, and an entry is only logged if all of
+// them accept it.
+//
+// Parameters:
+//   - filter: The Filter function to add.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithFilter(func(e loggo.Entry) bool {
+//		return !strings.Contains(e.Message, "healthcheck")
+//	}))
+func WithFilter(filter Filter) Option {
+	return func(l *Logger) {
+		l.filters = append(l.filters, filter)
+	}
+}
+
+// WithSink adds a sink to a Logger. Sinks receive the structured entries logged, after they are written to the output.
+//
+// Parameters:
+//   - sink: The Sink to add.
+//
+// Example:
+//
+//	observer := loggotest.NewObserver()
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithSink(observer))
+func WithSink(sink Sink) Option {
+	return func(l *Logger) {
+		l.sinks = append(l.sinks, &sinkState{sink: sink})
+	}
+}
+
+// WithFilteredSink adds a Sink to a Logger, receiving only the entries accepted by the filter, such as one compiled
+// from an expression with ParseFilter, to route entries without writing Go hooks.
+//
+// Parameters:
+//   - sink: The Sink receiving the entries.
+//   - filter: The Filter deciding which entries the sink receives.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo,
+//		loggo.WithFilteredSink(pager, loggo.MustParseFilter(`level>=error && component=="payments"`)))
+func WithFilteredSink(sink Sink, filter Filter) Option {
+	return func(l *Logger) {
+		l.sinks = append(l.sinks, &sinkState{sink: sink, filter: filter})
+	}
+}
+
+// WithSinkRetries configures the number of times a Logger retries sending an entry to a sink that failed to receive
+// it. By default, failed sink writes are not retried.
+//
+// Parameters:
+//   - retries: The number of retries.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithSink(collector), loggo.WithSinkRetries(3))
+func WithSinkRetries(retries int) Option {
+	return func(l *Logger) {
+		l.sinkRetries = retries
+	}
+}
+
+// WithWriteTimeout configures the maximum duration a Logger waits for a sink to receive an entry, so a hung collector
+// never stalls the logging. A timed out entry fails, without being retried, and is written to the dead-letter output,
+// if any, and the last error of the sink reported by Logger.Health is ErrWriteTimeout. The timed out write keeps
+// running in the background, and the entries sent to the sink until it returns time out immediately. There is no
+// timeout by default.
+//
+// Parameters:
+//   - timeout: The maximum duration of a sink write.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithSink(collector), loggo.WithWriteTimeout(200*time.Millisecond),
+//		loggo.WithDeadLetter(deadLetters))
+func WithWriteTimeout(timeout time.Duration) Option {
+	return func(l *Logger) {
+		l.writeTimeout = timeout
+	}
+}
+
+// WithDeadLetter configures a dead-letter output for a Logger. The entries a sink failed to receive, after its
+// retries, are appended to it as JSON lines with the failure reason, so nothing disappears silently and the failures
+// can be reprocessed with ReadDeadLetters.
+//
+// Parameters:
+//   - w: The dead-letter output, usually a file.
+//
+// Example:
+//
+//	deadLetters, err := loggo.OpenFile("dead-letters.jsonl")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithSink(collector), loggo.WithDeadLetter(deadLetters))
+func WithDeadLetter(w io.Writer) Option {
+	return func(l *Logger) {
+		l.deadLetter = w
+	}
+}
+
+// WithLatencyObserver configures a LatencyObserver receiving the time a Logger takes to render and write each entry.
+//
+// Parameters:
+//   - observer: The LatencyObserver to use.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithLatencyObserver(histograms))
+func WithLatencyObserver(observer LatencyObserver) Option {
+	return func(l *Logger) {
+		l.latency = observer
+	}
+}
+
+// WithTrace emits the entries of a Logger at or above the given level as runtime/trace user log events, while an
+// execution trace is being captured, so traces opened with "go tool trace" show the log context inline. The events
+// have the level as category and are attached to the task of the Context of the Logger, if any.
+//
+// Parameters:
+//   - level: The minimum level of the entries to emit.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithTrace(loggo.LevelWarn))
+func WithTrace(level Level) Option {
+	return func(l *Logger) {
+		l.trace = true
+		l.traceLevel = level
+	}
+}
+
+// WithColor configures when a Logger colors its log lines. With ColorAuto, the default, the lines are colored only
+// when the output is a terminal, enabling the processing of ANSI escape codes on Windows consoles, unless overridden
+// by the NO_COLOR and CLICOLOR_FORCE environment variables (see ColorMode.Enabled).
+// The colors are applied where the template references {{.Color}} and {{.Reset}}, as the default template does around
+// the level.
+//
+// Parameters:
+//   - mode: The ColorMode to use.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithColor(loggo.ColorNever))
+func WithColor(mode ColorMode) Option {
+	return func(l *Logger) {
+		l.colorMode = mode
+	}
+}
+
+// WithSummary makes a Logger log a one-line summary of the levels it logged when it is closed, at LevelInfo, e.g.
+// "logged 10234 info, 57 warn, 3 error over 2h13m", so batch jobs and CLIs self-report their noisiness.
+// The counts include the loggers derived from the Logger.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithSummary())
+//	defer logger.Close()
+func WithSummary() Option {
+	return func(l *Logger) {
+		l.summary = true
+	}
+}
+
+// WithAggregation makes a Logger count the entries of a level, and log a summary of them at the end of each window,
+// such as "42 errors in the last 1m (top: 'db timeout' x31)", for noisy failure modes. With suppress, the individual
+// entries are not logged, only the summaries. The aggregation stops when the Logger is closed, logging the summary of
+// its last window. The windows are timed by the clock of the Logger.
+//
+// Parameters:
+//   - level: The level of the entries to aggregate.
+//   - window: The duration of each window.
+//   - suppress: Whether to suppress the individual entries.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithAggregation(loggo.LevelError, time.Minute, true))
+//	defer logger.Close()
+func WithAggregation(level Level, window time.Duration, suppress bool) Option {
+	return func(l *Logger) {
+		l.aggregations = append(l.aggregations, &aggregation{level: level, window: window, suppress: suppress})
+	}
+}
+
+// WithAlert makes a Logger call the callback when more than limit entries of a level are logged within the sliding
+// window, e.g. to page someone or notify a webhook. The callback is called once, synchronously, when the rate exceeds
+// the limit, and again only after the rate dropped back within it. The window is measured with the times of the
+// entries.
+//
+// Parameters:
+//   - level: The level of the entries to watch.
+//   - limit: The maximum number of entries in the window.
+//   - window: The duration of the sliding window.
+//   - callback: The AlertFunc to call.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithAlert(loggo.LevelError, 50, time.Minute, func(a loggo.Alert) {
+//		notifier.Send(fmt.Sprintf("more than %d errors in %s, last: %s", a.Limit, a.Window, a.Entry.Message))
+//	}))
+func WithAlert(level Level, limit int, window time.Duration, callback AlertFunc) Option {
+	return func(l *Logger) {
+		l.alerts = append(l.alerts, &alert{level: level, limit: limit, window: window, callback: callback})
+	}
+}
+
+// WithStackFormat configures how a Logger renders the stack traces attached to the entries, such as the ones of
+// ReportPanic, to balance their context against the log volume.
+//
+// Parameters:
+//   - format: The StackFormat of the stack traces.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithStackFormat(loggo.StackFormat{Depth: 20, Fold: true}))
+func WithStackFormat(format StackFormat) Option {
+	return func(l *Logger) {
+		l.stackFormat = format
+	}
+}
+
+// WithPriorityPrefix makes a Logger prefix each line with the syslog priority of its level, e.g. "<4>" for LevelWarn,
+// so services logging to stdout or stderr under systemd get the right journal priorities without a journald socket.
+// The lines of multiline messages, such as stack traces, are prefixed too, as the journal records each line apart.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithPriorityPrefix(), loggo.WithTemplate("{{.Message}}"))
+//	logger.Warn("disk almost full")
+//	// Output: <4>disk almost full
+func WithPriorityPrefix() Option {
+	return func(l *Logger) {
+		l.priorityPrefix = true
+	}
+}
+
+// WithSplitOutput makes a Logger write the entries at the given level and above to a separate output, instead of its
+// output, with the same formatting.
+//
+// Parameters:
+//   - level: The minimum level of the entries written to the split output.
+//   - output: The io.Writer receiving the entries at the level and above.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(file), loggo.WithSplitOutput(loggo.LevelError, alerts))
+func WithSplitOutput(level Level, output io.Writer) Option {
+	return func(l *Logger) {
+		l.splitLevel = level
+		l.splitOutput = output
+		l.ownsSplitOutput = true
+	}
+}
+
+// WithStderrSplit makes a Logger write the entries up to LevelInfo to os.Stdout, and the entries at LevelWarn and
+// above to os.Stderr, with the same formatting, as CI systems and shell pipelines expect.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithStderrSplit())
+//	logger.Info("copying files") // Written to stdout
+//	logger.Warn("file skipped")  // Written to stderr
+func WithStderrSplit() Option {
+	return func(l *Logger) {
+		WithOutput(os.Stdout)(l)
+		WithSplitOutput(LevelWarn, os.Stderr)(l)
+	}
+}
+
+// WithErrorOutput configures the destination of the internal failures of a Logger, such as template errors or output
+// and sink write failures, as "loggo: <error>" lines. They are still returned by the methods returning errors, such as
+// LogE, but are otherwise discarded without an error output, so problems with the logging itself can go unnoticed.
+//
+// Parameters:
+//   - output: The io.Writer receiving the internal failures.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(conn), loggo.WithErrorOutput(os.Stderr))
+func WithErrorOutput(output io.Writer) Option {
+	return func(l *Logger) {
+		l.errorOutput = output
+	}
+}
+
+// WithTemplateDefs adds named templates to a Logger, that its templates, including its suffix and the ones of
+// WithTemplateOnce, can include with the template action, e.g. {{template "caller" .}}. Definitions of an existing name
+// replace it.
+//
+// Parameters:
+//   - defs: The TemplateDefs to add.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo,
+//		loggo.WithTemplateDefs(loggo.TemplateDefs{"header": "{{.Time}} [{{.Level}}]"}),
+//		loggo.WithTemplate(`{{template "header" .}} {{.Message}}`))
+//	logger.WithTemplateOnce(`{{template "header" .}} ===== {{.Message}} =====`).Info("Starting server")
+func WithTemplateDefs(defs TemplateDefs) Option {
+	return func(l *Logger) {
+		merged := make(TemplateDefs, len(l.templateDefs)+len(defs))
+		for name, def := range l.templateDefs {
+			merged[name] = def
+		}
+
+		for name, def := range defs {
+			merged[name] = def
+		}
+
+		l.templateDefs = merged
+	}
+}
+
+// WithTransformer adds a named Transformer to a Logger, see Logger.AddTransformer. Transformers are the extension point
+// of the components operating on the final entries, such as redaction, enrichment or sampling.
+//
+// Parameters:
+//   - name: The name of the transformer, to replace or remove it.
+//   - t: The Transformer to add.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithTransformer("region", func(entry loggo.Entry) (loggo.Entry, bool) {
+//		entry.Fields = append(entry.Fields, loggo.F("region", region))
+//		return entry, true
+//	}))
+func WithTransformer(name string, t Transformer) Option {
+	return func(l *Logger) {
+		l.transforms.add(name, t)
+	}
+}
+
+// WithDropHook configures the hook a Logger calls for every entry dropped before being written, by a filter, an
+// aggregation or a transformer, with the reason and the number of entries dropped for it so far, shared with the
+// loggers derived from it, so sampled and suppressed volume can be accounted for.
+//
+// Parameters:
+//   - hook: The DropHook to call.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithDropHook(func(d loggo.Drop) {
+//		droppedEntries.WithLabelValues(string(d.Reason)).Inc()
+//	}))
+func WithDropHook(hook DropHook) Option {
+	return func(l *Logger) {
+		l.dropHook = hook
+		l.drops = &drops{counts: map[DropReason]uint64{}}
+	}
+}
+
+// WithFallback configures the emergency output of a Logger: the entries at LevelError and above that could not be
+// written to its output, or that none of its sinks received, are written to it in a plain format, independent of the
+// template, e.g. "2024-09-03T15:04:05Z ERROR database unreachable", so operators still see catastrophic problems when
+// the logging pipeline itself is failing.
+//
+// Parameters:
+//   - output: The emergency io.Writer, usually os.Stderr.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(io.Discard), loggo.WithSink(collector),
+//		loggo.WithFallback(os.Stderr))
+func WithFallback(output io.Writer) Option {
+	return func(l *Logger) {
+		l.fallback = output
+	}
+}
+
+// WithFieldsFromFunc adds a function returning dynamic fields to a Logger, such as the current memory usage or the
+// number of active requests, appended to each entry. It is called only for the entries passing the Threshold.
+//
+// Parameters:
+//   - fn: The FieldsFunc returning the fields.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithFieldsFromFunc(func() loggo.Fields {
+//		return loggo.Fields{loggo.F("goroutines", runtime.NumGoroutine())}
+//	}))
+func WithFieldsFromFunc(fn FieldsFunc) Option {
+	return func(l *Logger) {
+		l.fieldFuncs = append(l.fieldFuncs, fn)
+	}
+}
+
+// WithContextFields adds a function returning fields from the context of a Logger, such as the request identifiers
+// carried by the context, appended to each entry after the dynamic fields. It is called with the context configured
+// with WithContext, only for the entries passing the Threshold.
+//
+// Parameters:
+//   - fn: The ContextFields function returning the fields.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithContextFields(func(ctx context.Context) loggo.Fields {
+//		if tenant, ok := ctx.Value(tenantKey{}).(string); ok {
+//			return loggo.Fields{loggo.F("tenant", tenant)}
+//		}
+//		return nil
+//	}))
+//	logger.Child(loggo.WithContext(ctx)).Info("handled")
+func WithContextFields(fn ContextFields) Option {
+	return func(l *Logger) {
+		l.ctxFields = append(l.ctxFields, fn)
+	}
+}
+
+// WithTemplateData registers a provider of a custom value of the template data of a Logger, rendered by
+// {{.Custom.<name>}}, making the templates extensible like the fields. The provider is called for every entry rendered.
+//
+// Parameters:
+//   - name: The name of the value, as referenced by the templates.
+//   - provider: The TemplateDataProvider of the value.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo,
+//		loggo.WithTemplateData("region", func(loggo.Entry) any { return os.Getenv("REGION") }),
+//		loggo.WithTemplate("{{.Time}} [{{.Custom.region}}] {{.Message}}"))
+func WithTemplateData(name string, provider TemplateDataProvider) Option {
+	return func(l *Logger) {
+		l.dataProviders = append(l.dataProviders, dataProvider{name: name, provider: provider})
+	}
+}
+
+// WithFormat configures the format of the log lines of a Logger, rendered by one of the built-in encoders with the
+// time format of the Logger. The default format is FormatText, rendering the template of the Logger; the other formats
+// ignore the template and its suffix, but still honor the line ending, the priority prefix and the checksum.
+//
+// Parameters:
+//   - format: The Format of the log lines.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithFormat(loggo.FormatJSON))
+//	logger.Info("started")
+//	// Output: {"time":"2024-09-03 15:04:05","level":"INFO","message":"started","caller":"main.go:12",...}
+func WithFormat(format Format) Option {
+	return func(l *Logger) {
+		l.format = format
+	}
+}
+
+// WithEncoder configures the Encoder of the log lines of a Logger, such as a built-in encoder with its own time format,
+// or a custom wire format. It takes precedence over the Format and the template of the Logger. The line ending, the
+// priority prefix and the checksum are still appended to the encoded lines.
+//
+// Parameters:
+//   - encoder: The Encoder of the log lines.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithEncoder(loggo.JSONEncoder{TimeFormat: loggo.TimeFormatEpochMillis}))
+func WithEncoder(encoder Encoder) Option {
+	return func(l *Logger) {
+		l.encoder = encoder
+	}
+}
+
+// WithTimeBucket configures a Logger to floor the time of the entries to a window, rendered in its time format by
+// {{.TimeBucket}}, and attached as a field if the bucket has a Field key, for the jobs grouping the entries by period,
+// such as deduplication jobs. By default, there is no time bucket.
+//
+// Parameters:
+//   - bucket: The TimeBucket of the entries.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo,
+//		loggo.WithTimeBucket(loggo.TimeBucket{Window: 5 * time.Minute, Field: "bucket"}))
+//	logger.Info("started")
+//	// Output: 2024-09-03 15:04:05 [ INFO]: started bucket="2024-09-03 15:00:00"
+func WithTimeBucket(bucket TimeBucket) Option {
+	return func(l *Logger) {
+		l.timeBucket = bucket
+	}
+}
+
+// WithDevelopment configures a Logger to panic on misuse, instead of tolerating it, to catch integration bugs in tests
+// rather than in production: logging after Close, a key without a value in the fields of With and the KV methods, a
+// nil output or sink, and a template that does not compile. By default, misuse is tolerated.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelDebug, loggo.WithDevelopment())
+//	logger.InfoKV("handled", "user") // panics: loggo: key user without a value
+func WithDevelopment() Option {
+	return func(l *Logger) {
+		l.development = true
+	}
+}
+
+// WithSchemaVersion configures a Logger to stamp each entry with a schema version, received by its sinks and rendered
+// under the "schema" key by the JSONEncoder, so the consumers of long-lived archives can upgrade the entries of older
+// versions with Migrate. By default, the entries have no schema version.
+//
+// Parameters:
+//   - version: The schema version of the entries.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithFormat(loggo.FormatJSON), loggo.WithSchemaVersion("2"))
+func WithSchemaVersion(version string) Option {
+	return func(l *Logger) {
+		l.schema = version
+	}
+}
+
+// WithSeverityProfile configures a Logger to report the severities of its levels in an external system, rendered by
+// {{.Severity.Number}} and {{.Severity.Name}} in the template, and under the "severity" and "severity_number" keys by
+// FormatJSON and FormatLogfmt. By default, the template renders the severities of SeveritySyslog, and the formats
+// report none. The prefix of WithPriorityPrefix always uses the syslog priorities, as expected by systemd.
+//
+// Parameters:
+//   - profile: The SeverityProfile, e.g. SeverityGoogleCloud.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithFormat(loggo.FormatJSON),
+//		loggo.WithSeverityProfile(loggo.SeverityGoogleCloud))
+//	logger.Warn("Disk almost full")
+//	// Output: {"time":"...","level":"WARN","severity":"WARNING","severity_number":400,...}
+func WithSeverityProfile(profile SeverityProfile) Option {
+	return func(l *Logger) {
+		l.severity = profile
+	}
+}
+
+// WithExitFunc configures the function called by the Fatal methods of a Logger to exit the process, after logging the
+// message and flushing the sinks and outputs, even if the message is discarded. It is called with the exit code 1. A
+// nil function disables the exit, e.g. in tests. By default, the Fatal methods call os.Exit, like the log package.
+// The Fatal methods of a Batch flush it before exiting, and Log does not exit, even at LevelFatal.
+//
+// Parameters:
+//   - exit: The function exiting the process, nil to not exit.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithExitFunc(func(code int) {
+//		cancel()
+//		os.Exit(code)
+//	}))
+func WithExitFunc(exit func(code int)) Option {
+	return func(l *Logger) {
+		l.exit = exit
+	}
+}
+
+// WithThreshold configures the Threshold of a Logger, e.g. a child logging a noisy subsystem at a higher level. A child
+// of a Logger with a dynamic level configured with it no longer follows the reloads of the level, see
+// WithDynamicLevel.
+//
+// Parameters:
+//   - level: The Threshold of the Logger.
+//
+// Example:
+//
+//	database := logger.Child(loggo.WithThreshold(loggo.LevelWarn))
+func WithThreshold(level Level) Option {
+	return func(l *Logger) {
+		l.threshold = newThreshold(level)
+	}
+}
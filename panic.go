@@ -0,0 +1,88 @@
+package loggo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ReportPanic logs a recovered panic as a single LevelError entry, "panic: <value>", with the given fields, such as the
+// ones of a request returned by RequestFields, followed by the stack of the panicking goroutine in the "stack" field,
+// rendered in the StackFormat of the logger. It is meant to be shared by recovery middlewares, such as RecoverHTTP,
+// and RPC interceptors.
+//
+// Parameters:
+//   - recovered: The value returned by recover.
+//   - fields: Variadic fields describing the work that panicked.
+//
+// Example:
+//
+//	defer func() {
+//		if r := recover(); r != nil {
+//			logger.ReportPanic(r, loggo.F("rpc", info.FullMethod))
+//			err = status.Error(codes.Internal, "internal error")
+//		}
+//	}()
+func (l *Logger) ReportPanic(recovered any, fields ...Field) {
+	fields = append(fields[:len(fields):len(fields)], F("stack", l.stack(1)))
+	_ = l.log(LevelError, text(fmt.Sprint("panic: ", recovered)), fields...)
+}
+
+// RequestFields returns the fields describing an HTTP request: its method, its path, and the values of the listed
+// headers, if present, as "header.<Name>". Only list the headers that are safe to log.
+//
+// Parameters:
+//   - r: The HTTP request.
+//   - headers: Variadic names of the headers to include.
+//
+// Returns:
+//   - The fields of the request.
+//
+// Example:
+//
+//	logger.ReportPanic(recovered, loggo.RequestFields(r, "User-Agent", "X-Request-Id")...)
+func RequestFields(r *http.Request, headers ...string) Fields {
+	fields := Fields{F("method", r.Method), F("path", r.URL.Path)}
+
+	for _, name := range headers {
+		if value := r.Header.Get(name); value != "" {
+			fields = append(fields, F("header."+http.CanonicalHeaderKey(name), value))
+		}
+	}
+
+	return fields
+}
+
+// RecoverHTTP returns a middleware recovering the panics of the handler: each panic is reported with ReportPanic,
+// with the fields of the request and the listed headers, and answered with a 500 Internal Server Error.
+// The http.ErrAbortHandler panics, used to abort a response, are not reported.
+//
+// Parameters:
+//   - next: The handler to protect.
+//   - headers: Variadic names of the request headers to include in the reports.
+//
+// Returns:
+//   - The protected handler.
+//
+// Example:
+//
+//	http.ListenAndServe(":8080", logger.RecoverHTTP(mux, "User-Agent", "X-Request-Id"))
+func (l *Logger) RecoverHTTP(next http.Handler, headers ...string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+
+			if err, ok := recovered.(error); ok && errors.Is(err, http.ErrAbortHandler) {
+				panic(recovered)
+			}
+
+			l.ReportPanic(recovered, RequestFields(r, headers...)...)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}()
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,192 @@
+package loggo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+func TestLogger_RecoverHTTP(t *testing.T) {
+	observer := loggotest.NewObserver()
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(&loggotest.Recorder{}), loggo.WithSink(observer))
+
+	handler := logger.RecoverHTTP(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("nil map")
+	}), "User-Agent", "X-Request-Id", "Authorization")
+
+	req := httptest.NewRequest(http.MethodPost, "/orders?id=1", nil)
+	req.Header.Set("User-Agent", "curl/8.0")
+	req.Header.Set("Authorization", "Bearer secret")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusInternalServerError)
+	}
+
+	entries := observer.Entries()
+	if len(entries) != 1 {
+		t.Fatalf("entries = %d, want 1", len(entries))
+	}
+
+	entry := entries[0]
+	if entry.Level != loggo.LevelError || entry.Message != "panic: nil map" {
+		t.Errorf("entry = %s %q, want ERROR \"panic: nil map\"", entry.Level, entry.Message)
+	}
+
+	wantFields := loggo.Fields{
+		loggo.F("method", "POST"),
+		loggo.F("path", "/orders"),
+		loggo.F("header.User-Agent", "curl/8.0"),
+		loggo.F("header.Authorization", "Bearer secret"),
+	}
+
+	if got := entry.Fields[:len(entry.Fields)-1]; !reflect.DeepEqual(got, wantFields) {
+		t.Errorf("fields = %v, want %v", got, wantFields)
+	}
+
+	if stack, _ := entry.Fields.Get("stack"); !strings.Contains(stack.(string), "TestLogger_RecoverHTTP") {
+		t.Errorf("stack = %v, want the stack of the panicking goroutine", stack)
+	}
+}
+
+func TestLogger_RecoverHTTP_abort(t *testing.T) {
+	observer := loggotest.NewObserver()
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(&loggotest.Recorder{}), loggo.WithSink(observer))
+
+	handler := logger.RecoverHTTP(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+
+	defer func() {
+		if recover() != http.ErrAbortHandler {
+			t.Error("RecoverHTTP() did not re-panic http.ErrAbortHandler")
+		}
+
+		if len(observer.Entries()) != 0 {
+			t.Error("RecoverHTTP() reported http.ErrAbortHandler")
+		}
+	}()
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+}
+
+func TestLogger_ReportPanic(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Level}} {{.Message}}{{with .Fields}} {{.}}{{end}}"))
+
+	func() {
+		defer func() {
+			logger.ReportPanic(recover(), loggo.F("rpc", "/orders.Orders/Create"))
+		}()
+
+		panic("boom")
+	}()
+
+	if !strings.HasPrefix(w.String(), `ERROR panic: boom rpc=/orders.Orders/Create stack="goroutine `) || strings.Count(w.String(), "\n") != 1 {
+		t.Errorf("output = %q, want a single line report", w.String())
+	}
+}
+
+func TestLogger_Panic(t *testing.T) {
+	type testCase struct {
+		name      string
+		threshold loggo.Level
+		panic     func(l *loggo.Logger)
+		want      string
+		wantValue any
+	}
+
+	testCases := []testCase{
+		{
+			name:      "panic",
+			threshold: loggo.LevelInfo,
+			panic:     func(l *loggo.Logger) { l.Panic("nil map") },
+			want:      "PANIC nil map\n",
+			wantValue: "nil map",
+		},
+		{
+			name:      "panicf",
+			threshold: loggo.LevelInfo,
+			panic:     func(l *loggo.Logger) { l.Panicf("nil map %q", "users") },
+			want:      "PANIC nil map \"users\"\n",
+			wantValue: "nil map \"users\"",
+		},
+		{
+			name:      "panicKV",
+			threshold: loggo.LevelInfo,
+			panic:     func(l *loggo.Logger) { l.PanicKV("nil map", "map", "users") },
+			want:      "PANIC nil map map=users\n",
+			wantValue: "nil map",
+		},
+		{
+			name:      "discarded",
+			threshold: loggo.LevelFatal,
+			panic:     func(l *loggo.Logger) { l.Panic("nil map") },
+			want:      "",
+			wantValue: "nil map",
+		},
+		{
+			name:      "muted",
+			threshold: loggo.LevelInfo,
+			panic: func(l *loggo.Logger) {
+				l.Mute()
+				l.Panicf("nil map %q", "users")
+			},
+			want:      "",
+			wantValue: "nil map \"users\"",
+		},
+		{
+			name:      "skipped by if",
+			threshold: loggo.LevelInfo,
+			panic:     func(l *loggo.Logger) { l.If(false).PanicKV("nil map", "map", "users") },
+			want:      "",
+			wantValue: nil,
+		},
+		{
+			name:      "batch",
+			threshold: loggo.LevelInfo,
+			panic: func(l *loggo.Logger) {
+				b := l.Batch()
+				b.Info("loading")
+				b.Panicf("nil map %q", "users")
+			},
+			want:      "INFO loading\nPANIC nil map \"users\"\n",
+			wantValue: "nil map \"users\"",
+		},
+		{
+			name:      "batch skipped by if",
+			threshold: loggo.LevelInfo,
+			panic:     func(l *loggo.Logger) { l.If(false).Batch().Panic("nil map") },
+			want:      "",
+			wantValue: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			logger := loggo.New(tc.threshold, loggo.WithOutput(w), loggo.WithTemplate("{{.Level}} {{.Message}}{{with .Fields}} {{.}}{{end}}"))
+
+			func() {
+				defer func() {
+					if got := recover(); got != tc.wantValue {
+						t.Errorf("recovered %v, want %v", got, tc.wantValue)
+					}
+				}()
+
+				tc.panic(logger)
+			}()
+
+			if w.String() != tc.want {
+				t.Errorf("output = %q, want %q", w.String(), tc.want)
+			}
+		})
+	}
+}
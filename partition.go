@@ -0,0 +1,143 @@
+package loggo
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// PartitionFunc opens the Logger of a partition, given its key, e.g. one writing to the file of a tenant.
+type PartitionFunc func(key string) (*Logger, error)
+
+// Partition is a Sink routing each entry to the Logger of its partition, keyed by the value of one of its fields, such
+// as a tenant ID, to keep the logs of each key separate, e.g. in one file or stream per tenant. The entries are
+// replayed to the Logger of their partition preserving their level, time and caller. The Loggers are opened on the
+// first entry of their key, and only a bounded number of them stay open: the least recently used one is closed when
+// another must be opened, and opened again on its next entry. It is safe for concurrent use.
+//
+// The Loggers of the partitions must not be derived from the Logger writing to the Partition, as they would share the
+// output lock.
+type Partition struct {
+	mu      sync.Mutex
+	field   string
+	open    PartitionFunc
+	maxOpen int
+	lru     *list.List               // Open partitions, the most recently used first
+	loggers map[string]*list.Element // Open partitions, by key
+}
+
+// partition is an open partition of a Partition.
+type partition struct {
+	key    string
+	logger *Logger
+}
+
+// NewPartition returns a Partition routing the entries by the value of the field, keeping at most maxOpen Loggers
+// open. The entries without the field are routed to the partition of the empty key.
+//
+// Parameters:
+//   - field: The key of the field the entries are partitioned by.
+//   - maxOpen: The maximum number of Loggers kept open, at least 1.
+//   - open: The PartitionFunc opening the Logger of a partition.
+//
+// Returns:
+//   - A pointer to the Partition.
+//
+// Example:
+//
+//	tenants := loggo.NewPartition("tenant", 100, func(tenant string) (*loggo.Logger, error) {
+//		file, err := loggo.OpenFile(filepath.Join("/var/log/audit", filepath.Base(tenant)+".log"))
+//		if err != nil {
+//			return nil, err
+//		}
+//
+//		return loggo.New(loggo.LevelInfo, loggo.WithOutput(file), loggo.WithFormat(loggo.FormatJSON)), nil
+//	})
+//	defer tenants.Close()
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithSink(tenants))
+//	logger.With("tenant", "acme").Info("invoice approved")
+func NewPartition(field string, maxOpen int, open PartitionFunc) *Partition {
+	return &Partition{
+		field:   field,
+		open:    open,
+		maxOpen: max(maxOpen, 1),
+		lru:     list.New(),
+		loggers: map[string]*list.Element{},
+	}
+}
+
+// WriteEntry replays the entry to the Logger of its partition, opening it if needed.
+//
+// Returns:
+//   - An error if the Logger of the partition could not be opened, or failed to log the entry, or the Logger of the
+//     least recently used partition could not be closed, nil otherwise.
+func (p *Partition) WriteEntry(entry Entry) error {
+	var key string
+	if value, ok := entry.Fields.Get(p.field); ok {
+		key = fmt.Sprint(value)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if elem, ok := p.loggers[key]; ok {
+		p.lru.MoveToFront(elem)
+
+		return elem.Value.(*partition).logger.replay(entry)
+	}
+
+	var errs []error
+
+	if p.lru.Len() == p.maxOpen {
+		errs = append(errs, p.evict(p.lru.Back()))
+	}
+
+	logger, err := p.open(key)
+	if err != nil {
+		return errors.Join(append(errs, errors.New("error opening partition "+key+": "+err.Error()))...)
+	}
+
+	p.loggers[key] = p.lru.PushFront(&partition{key: key, logger: logger})
+
+	return errors.Join(append(errs, logger.replay(entry))...)
+}
+
+// Len returns the number of partitions open.
+func (p *Partition) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.lru.Len()
+}
+
+// Close closes the Loggers of the open partitions. The Partition can still receive entries afterward, opening the
+// partitions again.
+//
+// Returns:
+//   - An error if a Logger could not be closed, nil otherwise.
+func (p *Partition) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var errs []error
+
+	for p.lru.Len() > 0 {
+		errs = append(errs, p.evict(p.lru.Back()))
+	}
+
+	return errors.Join(errs...)
+}
+
+// evict closes the Logger of the open partition, and forgets it. The lock must be held.
+func (p *Partition) evict(elem *list.Element) error {
+	part := p.lru.Remove(elem).(*partition)
+	delete(p.loggers, part.key)
+
+	if err := part.logger.Close(); err != nil {
+		return errors.New("error closing partition " + part.key + ": " + err.Error())
+	}
+
+	return nil
+}
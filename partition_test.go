@@ -0,0 +1,75 @@
+package loggo_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+// partitionOutput is an output recording its writes and when it is closed.
+type partitionOutput struct {
+	key    string
+	events *[]string
+}
+
+func (r partitionOutput) Write(p []byte) (int, error) {
+	*r.events = append(*r.events, r.key+": "+strings.TrimSuffix(string(p), "\n"))
+
+	return len(p), nil
+}
+
+func (r partitionOutput) Close() error {
+	*r.events = append(*r.events, r.key+" closed")
+
+	return nil
+}
+
+func TestPartition(t *testing.T) {
+	var events []string
+	tenants := loggo.NewPartition("tenant", 2, func(key string) (*loggo.Logger, error) {
+		if key == "broken" {
+			return nil, errors.New("no space left")
+		}
+
+		events = append(events, key+" opened")
+
+		return loggo.New(loggo.LevelInfo, loggo.WithOutput(partitionOutput{key: key, events: &events}),
+			loggo.WithTemplate("{{.Level}} {{.Message}}")), nil
+	})
+
+	logger := loggo.New(loggo.LevelDebug, loggo.WithOutput(&strings.Builder{}), loggo.WithSink(tenants))
+
+	logger.With("tenant", "acme").Info("approved")
+	logger.With("tenant", "globex").Warn("rejected")
+	logger.With("tenant", "acme").Debug("discarded by the partition")
+	logger.With("tenant", "acme").Info("paid")
+	logger.With("tenant", 42).Info("created")
+	logger.Info("untagged")
+
+	if err := logger.With("tenant", "broken").LogE(loggo.LevelInfo, "lost"); err == nil ||
+		!strings.Contains(err.Error(), "error opening partition broken: no space left") {
+		t.Errorf("LogE() error = %v, want an error opening the partition", err)
+	}
+
+	if n := tenants.Len(); n != 1 {
+		t.Errorf("Len() = %d, want 1", n)
+	}
+
+	if err := logger.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"acme opened", "acme: INFO approved",
+		"globex opened", "globex: WARN rejected",
+		"acme: INFO paid",
+		"globex closed", "42 opened", "42: INFO created",
+		"acme closed", " opened", ": INFO untagged",
+		"42 closed", " closed",
+	}
+	if strings.Join(events, "\n") != strings.Join(want, "\n") {
+		t.Errorf("events = %q, want %q", events, want)
+	}
+}
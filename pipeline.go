@@ -0,0 +1,254 @@
+package loggo
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// PipelineBuilder describes a pipeline of stages processing the entries of a Logger, built into a PipelineSink: the
+// entries go through its filters and samplers, in order, then are sent to its sinks, and written to its outputs
+// encoded by its encoder. Each call adds a stage and returns the builder, so a pipeline reads as a single expression.
+// A PipelineBuilder is not safe for concurrent use.
+type PipelineBuilder struct {
+	stages  []pipelineStage
+	encoder Encoder
+	outputs []io.Writer
+	sinks   []Sink
+	errs    []error
+}
+
+// pipelineStage is a filtering stage of a pipeline, with its description.
+type pipelineStage struct {
+	name   string
+	accept func(entry Entry) bool
+}
+
+// PipelineSink is a Sink running the entries through the stages of a pipeline, see Pipeline. It is safe for
+// concurrent use.
+type PipelineSink struct {
+	mu      sync.Mutex
+	stages  []pipelineStage
+	encoder Encoder
+	outputs []io.Writer
+	sinks   []Sink
+}
+
+// Pipeline returns an empty PipelineBuilder, to describe a multi-stage configuration as a readable expression, and
+// test it as data, instead of nesting sinks and filters.
+//
+// Returns:
+//   - A pointer to the PipelineBuilder.
+//
+// Example:
+//
+//	pipeline, err := loggo.Pipeline().
+//		Where(`level>=warn || component=="payments"`).
+//		Sample(0.1).
+//		Encode(loggo.JSONEncoder{TimeFormat: loggo.TimeFormatRFC3339}).
+//		To(file).
+//		ToSinks(collector).
+//		Build()
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithSink(pipeline))
+func Pipeline() *PipelineBuilder {
+	return &PipelineBuilder{}
+}
+
+// Filter adds a stage keeping the entries accepted by the filter.
+//
+// Parameters:
+//   - filter: The Filter of the entries.
+//
+// Returns:
+//   - The PipelineBuilder.
+func (b *PipelineBuilder) Filter(filter Filter) *PipelineBuilder {
+	b.stages = append(b.stages, pipelineStage{name: "filter", accept: filter})
+
+	return b
+}
+
+// Where adds a stage keeping the entries matching the filter expression, see ParseFilter. An invalid expression is
+// reported by Build.
+//
+// Parameters:
+//   - expr: The filter expression, e.g. `level>=warn`.
+//
+// Returns:
+//   - The PipelineBuilder.
+func (b *PipelineBuilder) Where(expr string) *PipelineBuilder {
+	filter, err := ParseFilter(expr)
+	if err != nil {
+		b.errs = append(b.errs, err)
+
+		return b
+	}
+
+	b.stages = append(b.stages, pipelineStage{name: "where " + expr, accept: filter})
+
+	return b
+}
+
+// Sample adds a stage keeping the given proportion of the entries. The sample is deterministic: with a rate of 0.1,
+// every tenth entry reaching the stage is kept.
+//
+// Parameters:
+//   - rate: The proportion of the entries kept, from 0 for none to 1 for all of them.
+//
+// Returns:
+//   - The PipelineBuilder.
+func (b *PipelineBuilder) Sample(rate float64) *PipelineBuilder {
+	rate = min(max(rate, 0), 1)
+	credit := 0.0
+
+	b.stages = append(b.stages, pipelineStage{
+		name: "sample " + strconv.FormatFloat(rate, 'g', -1, 64),
+		accept: func(Entry) bool {
+			credit += rate
+			if credit < 1 {
+				return false
+			}
+
+			credit--
+
+			return true
+		},
+	})
+
+	return b
+}
+
+// Encode configures the encoder of the lines written to the outputs of the pipeline. The default encoder is a
+// JSONEncoder.
+//
+// Parameters:
+//   - encoder: The Encoder of the lines, e.g. LogfmtEncoder{}.
+//
+// Returns:
+//   - The PipelineBuilder.
+func (b *PipelineBuilder) Encode(encoder Encoder) *PipelineBuilder {
+	b.encoder = encoder
+
+	return b
+}
+
+// To adds outputs the entries kept by the pipeline are written to, one encoded line each.
+//
+// Parameters:
+//   - outputs: The outputs of the lines.
+//
+// Returns:
+//   - The PipelineBuilder.
+func (b *PipelineBuilder) To(outputs ...io.Writer) *PipelineBuilder {
+	b.outputs = append(b.outputs, outputs...)
+
+	return b
+}
+
+// ToSinks adds sinks the entries kept by the pipeline are sent to.
+//
+// Parameters:
+//   - sinks: The sinks of the entries.
+//
+// Returns:
+//   - The PipelineBuilder.
+func (b *PipelineBuilder) ToSinks(sinks ...Sink) *PipelineBuilder {
+	b.sinks = append(b.sinks, sinks...)
+
+	return b
+}
+
+// Build returns the PipelineSink running the stages described by the builder. The builder must not be used
+// afterward, as the PipelineSink shares the state of its samplers.
+//
+// Returns:
+//   - A pointer to the PipelineSink.
+//   - An error if an expression is invalid, or the pipeline has no output nor sink, nil otherwise.
+func (b *PipelineBuilder) Build() (*PipelineSink, error) {
+	errs := b.errs
+	if len(b.outputs) == 0 && len(b.sinks) == 0 {
+		errs = append(errs, errors.New("no output nor sink"))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, errors.New("error building pipeline: " + err.Error())
+	}
+
+	encoder := b.encoder
+	if encoder == nil {
+		encoder = JSONEncoder{}
+	}
+
+	return &PipelineSink{stages: b.stages, encoder: encoder, outputs: b.outputs, sinks: b.sinks}, nil
+}
+
+// WriteEntry runs the entry through the stages of the pipeline, then sends it to its sinks and writes it to its
+// outputs if it is kept.
+//
+// Returns:
+//   - An error if the entry could not be encoded, or a sink or output failed, nil otherwise.
+func (p *PipelineSink) WriteEntry(entry Entry) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, stage := range p.stages {
+		if !stage.accept(entry) {
+			return nil
+		}
+	}
+
+	var errs []error
+
+	for _, sink := range p.sinks {
+		errs = append(errs, sink.WriteEntry(entry))
+	}
+
+	if len(p.outputs) > 0 {
+		line, err := p.encoder.Encode(entry)
+		if err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+
+		line = append(line, '\n')
+
+		for _, output := range p.outputs {
+			_, err = output.Write(line)
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// String describes the stages of the pipeline, e.g. "where level>=warn | sample 0.1 | 1 output | 1 sink".
+func (p *PipelineSink) String() string {
+	var parts []string
+
+	for _, stage := range p.stages {
+		parts = append(parts, stage.name)
+	}
+
+	if len(p.outputs) > 0 {
+		parts = append(parts, plural(len(p.outputs), "output"))
+	}
+
+	if len(p.sinks) > 0 {
+		parts = append(parts, plural(len(p.sinks), "sink"))
+	}
+
+	return strings.Join(parts, " | ")
+}
+
+// plural returns the count followed by the noun, in plural if the count is not 1.
+func plural(count int, noun string) string {
+	if count != 1 {
+		noun += "s"
+	}
+
+	return strconv.Itoa(count) + " " + noun
+}
@@ -0,0 +1,94 @@
+package loggo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestPipeline(t *testing.T) {
+	w := &strings.Builder{}
+	pipeline, err := loggo.Pipeline().
+		Where("level>=warn").
+		Filter(func(entry loggo.Entry) bool { return !strings.Contains(entry.Message, "noisy") }).
+		Sample(0.5).
+		Encode(loggo.LogfmtEncoder{}).
+		To(w).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := pipeline.String(), "where level>=warn | filter | sample 0.5 | 1 output"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	logger := loggo.New(loggo.LevelDebug, loggo.WithOutput(&strings.Builder{}), loggo.WithSink(pipeline),
+		loggo.WithTimeProvider(fakeNow), loggo.WithCallerProvider(func() (uintptr, string, int, bool) {
+			return 0, "app.go", 7, true
+		}))
+
+	logger.Info("discarded by the level")
+	logger.Warn("disk full")
+	logger.Error("noisy")
+	logger.Error("disk failed")
+	logger.Warn("disk still full")
+	logger.Error("disk gone")
+
+	want := `time="2022-01-25 00:00:00" level=ERROR msg="disk failed" caller=app.go:7` + "\n" +
+		`time="2022-01-25 00:00:00" level=ERROR msg="disk gone" caller=app.go:7` + "\n"
+	if w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
+
+func TestPipeline_Build(t *testing.T) {
+	type testCase struct {
+		name    string
+		builder *loggo.PipelineBuilder
+		want    string
+	}
+
+	testCases := []testCase{
+		{
+			name:    "no destination",
+			builder: loggo.Pipeline().Sample(0.1),
+			want:    "error building pipeline: no output nor sink",
+		},
+		{
+			name:    "invalid expression",
+			builder: loggo.Pipeline().Where("level>>warn").To(&strings.Builder{}),
+			want:    "error building pipeline: ",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := tc.builder.Build(); err == nil || !strings.HasPrefix(err.Error(), tc.want) {
+				t.Errorf("Build() error = %v, want %q", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestPipeline_sinks(t *testing.T) {
+	var got []string
+	sink := flushRecorder{events: &got}
+
+	pipeline, err := loggo.Pipeline().ToSinks(sink, sink).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(&strings.Builder{}), loggo.WithSink(pipeline))
+	logger.Info("started")
+
+	if strings.Join(got, ",") != "write started,write started" {
+		t.Errorf("entries = %q, want the entry sent to both sinks", got)
+	}
+
+	if got, want := pipeline.String(), "2 sinks"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,40 @@
+package loggo
+
+// NewContainer creates a new Logger with the given threshold, configured for containers, such as Kubernetes pods,
+// whose log collectors expect a JSON object per line on the standard output: the lines are rendered by a JSONEncoder,
+// like FormatJSON, with the "caller" and "function" keys only at LevelError and above. The time is rendered in UTC with
+// TimeFormatRFC3339Nano and the lines are never colored. The options are applied after the preset ones, so they can
+// override them, e.g. WithTimeFormat or WithEncoder.
+//
+// Parameters:
+//   - threshold: Minimum log level to output.
+//   - options: Variadic options to configure the Logger.
+//
+// Returns:
+//   - A pointer to the newly created Logger.
+//
+// Example:
+//
+//	logger := loggo.NewContainer(loggo.LevelInfo)
+//	logger.With("user", 42).Info("started")
+//	// Output: {"time":"2024-09-03T15:04:05.123456789Z","level":"INFO","message":"started","fields":{"user":42}}
+func NewContainer(threshold Level, options ...Option) *Logger {
+	preset := []Option{
+		WithFormat(FormatJSON),
+		WithTimeFormat(TimeFormatRFC3339Nano),
+		WithUTC(),
+		WithColor(ColorNever),
+	}
+
+	return New(threshold, append(append(preset, options...), withContainerEncoder)...)
+}
+
+// withContainerEncoder configures the JSONEncoder of a Logger created by NewContainer, with the time format and
+// severity profile of the Logger, unless the options replaced its format or encoder.
+func withContainerEncoder(l *Logger) {
+	if l.format != FormatJSON || l.encoder != nil {
+		return
+	}
+
+	l.encoder = JSONEncoder{TimeFormat: l.timeFormat, Severity: l.severity, CallerLevel: LevelError}
+}
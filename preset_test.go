@@ -0,0 +1,71 @@
+package loggo_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestNewContainer(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.NewContainer(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTimeProvider(fakeNow),
+		loggo.WithCallerFormat(loggo.CallerFormatBase))
+
+	logger.With("level", "x").Info(`said "hi"`)
+
+	release := loggo.PushFields(loggo.F("user", 42), loggo.F("err", errors.New("timeout")))
+	logger.Error("failed")
+	release()
+
+	logger.Log(loggo.LevelPanic, "recovered")
+	logger.Log(levelAudit, "approved")
+
+	lines := strings.Split(strings.TrimSuffix(w.String(), "\n"), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("lines = %q, want 4 lines", lines)
+	}
+
+	want := `{"time":"2022-01-25T00:00:00Z","level":"INFO","message":"said \"hi\"","fields":{"level":"x"}}`
+	if lines[0] != want {
+		t.Errorf("line = %s, want %s", lines[0], want)
+	}
+
+	for i, line := range lines[1:] {
+		var entry map[string]any
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("line %s is not valid JSON: %v", line, err)
+		}
+
+		caller, _ := entry["caller"].(string)
+		if !strings.HasPrefix(caller, "preset_test.go:") || entry["function"] != "loggo_test.TestNewContainer" {
+			t.Errorf("line %d = %s, want the caller and function of the test", i+1, line)
+		}
+	}
+
+	var entry struct {
+		Message string         `json:"message"`
+		Fields  map[string]any `json:"fields"`
+	}
+	if err := json.Unmarshal([]byte(lines[1]), &entry); err != nil {
+		t.Fatal(err)
+	}
+
+	if entry.Message != "failed" || entry.Fields["user"] != 42.0 || entry.Fields["err"] != "timeout" {
+		t.Errorf("entry = %+v, want the message and fields", entry)
+	}
+}
+
+func TestNewContainer_options(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.NewContainer(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTimeProvider(fakeNow),
+		loggo.WithTimeFormat(loggo.TimeFormatEpochSeconds))
+
+	logger.Info("started")
+
+	if want := `{"time":1643068800,"level":"INFO","message":"started"}` + "\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
@@ -0,0 +1,29 @@
+package loggo
+
+import (
+	"bytes"
+	"strconv"
+)
+
+// prefixPriority prefixes each line rendered in the buffer from start with the syslog priority of the level, as
+// expected by systemd on the standard output and error of services, e.g. "<4>".
+func (l *Logger) prefixPriority(buf *bytes.Buffer, start int, level Level) {
+	prefix := []byte("<" + strconv.Itoa(level.Priority()) + ">")
+	body := bytes.TrimSuffix(buf.Bytes()[start:], []byte(l.lineEnding))
+	lines := bytes.Split(body, []byte("\n"))
+
+	rendered := make([]byte, 0, len(body)+len(lines)*len(prefix)+len(l.lineEnding))
+	for i, line := range lines {
+		if i > 0 {
+			rendered = append(rendered, '\n')
+		}
+
+		rendered = append(rendered, prefix...)
+		rendered = append(rendered, line...)
+	}
+
+	rendered = append(rendered, l.lineEnding...)
+
+	buf.Truncate(start)
+	buf.Write(rendered)
+}
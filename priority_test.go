@@ -0,0 +1,49 @@
+package loggo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestWithPriorityPrefix(t *testing.T) {
+	type testCase struct {
+		name    string
+		level   loggo.Level
+		message string
+		options []loggo.Option
+		want    string
+	}
+
+	testCases := []testCase{
+		{name: "debug", level: loggo.LevelDebug, message: "message", want: "<7>message\n"},
+		{name: "info", level: loggo.LevelInfo, message: "message", want: "<6>message\n"},
+		{name: "warn", level: loggo.LevelWarn, message: "message", want: "<4>message\n"},
+		{name: "error", level: loggo.LevelError, message: "message", want: "<3>message\n"},
+		{name: "fatal", level: loggo.LevelFatal, message: "message", want: "<2>message\n"},
+		{name: "multiline", level: loggo.LevelError, message: "first\nsecond", want: "<3>first\n<3>second\n"},
+		{
+			name:    "crlf",
+			level:   loggo.LevelWarn,
+			message: "message",
+			options: []loggo.Option{loggo.WithLineEnding(loggo.LineEndingCRLF)},
+			want:    "<4>message\r\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			options := append([]loggo.Option{loggo.WithOutput(w), loggo.WithTemplate("{{.Message}}"),
+				loggo.WithPriorityPrefix()}, tc.options...)
+			logger := loggo.New(loggo.LevelDebug, options...)
+
+			_ = logger.LogE(tc.level, tc.message)
+
+			if w.String() != tc.want {
+				t.Errorf("output = %q, want %q", w.String(), tc.want)
+			}
+		})
+	}
+}
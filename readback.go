@@ -0,0 +1,285 @@
+package loggo
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"time"
+)
+
+// jsonEntry is an entry rendered by a JSONEncoder, as read back from a File.
+type jsonEntry struct {
+	Time      json.RawMessage `json:"time"`
+	Level     string          `json:"level"`
+	Message   string          `json:"message"`
+	Caller    string          `json:"caller"`
+	Function  string          `json:"function"`
+	Component string          `json:"component"`
+	ID        string          `json:"id"`
+	Scope     string          `json:"scope"`
+	Schema    string          `json:"schema"`
+	Service   Service         `json:"service"`
+	Build     Build           `json:"build"`
+	Fields    jsonFields      `json:"fields"`
+}
+
+// jsonFields are the fields of a jsonEntry, decoded in order, with their numbers as json.Number.
+type jsonFields Fields
+
+// WithReadTimeFormat configures the time format of the entries read back from a File by Tail and ReadRange, which
+// must be the time format of the Logger writing them. The default time format is TimeFormatDefault, parsed in the
+// local time zone when it has none.
+//
+// Parameters:
+//   - format: The time format of the entries, e.g. TimeFormatRFC3339 or TimeFormatEpochMillis.
+//
+// Example:
+//
+//	file, err := loggo.OpenFile("app.log", loggo.WithReadTimeFormat(loggo.TimeFormatRFC3339))
+func WithReadTimeFormat(format string) FileOption {
+	return func(f *File) {
+		f.layout = format
+	}
+}
+
+// Tail returns the last n entries of the File, oldest first, reading back the rotated files when the current one has
+// fewer entries, so an admin endpoint can expose the recent logs. The rotated files are the ones named after the File
+// with a numeric suffix, the lowest being the most recent, optionally gzipped, e.g. "app.log.1" and "app.log.2.gz", as
+// written by logrotate. Only the lines written by FormatJSON to a UTF-8 File are read back, the others are skipped.
+//
+// Parameters:
+//   - n: The maximum number of entries to return.
+//
+// Returns:
+//   - The last n entries, or fewer if the files hold fewer.
+//   - An error if a file could not be read, nil otherwise.
+//
+// Example:
+//
+//	entries, err := file.Tail(100)
+func (f *File) Tail(n int) ([]Entry, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+
+	var entries []Entry
+
+	for i := 0; len(entries) < n; i++ {
+		older, ok, err := f.readEntries(i)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			break
+		}
+
+		entries = append(older, entries...)
+	}
+
+	return entries[max(len(entries)-n, 0):], nil
+}
+
+// ReadRange returns the entries of the File logged from the from time, included, to the to time, excluded, oldest
+// first, reading back the current and rotated files, see Tail.
+//
+// Parameters:
+//   - from: The time of the first entries to return.
+//   - to: The time after the last entries to return.
+//
+// Returns:
+//   - The entries logged in the range.
+//   - An error if a file could not be read, nil otherwise.
+//
+// Example:
+//
+//	entries, err := file.ReadRange(time.Now().Add(-time.Hour), time.Now())
+func (f *File) ReadRange(from, to time.Time) ([]Entry, error) {
+	var entries []Entry
+
+	for i := 0; ; i++ {
+		older, ok, err := f.readEntries(i)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			break
+		}
+
+		var inRange []Entry
+		for _, entry := range older {
+			if !entry.Time.Before(from) && entry.Time.Before(to) {
+				inRange = append(inRange, entry)
+			}
+		}
+
+		entries = append(inRange, entries...)
+
+		if len(older) > 0 && older[0].Time.Before(from) {
+			break
+		}
+	}
+
+	return entries, nil
+}
+
+// readEntries returns the entries of the current file, for the generation 0, or of the rotated file of the
+// generation, and whether the file exists.
+func (f *File) readEntries(generation int) ([]Entry, bool, error) {
+	if f.charset != CharsetUTF8 {
+		return nil, false, errors.New("error reading back file: only UTF-8 files can be read back")
+	}
+
+	var (
+		content []byte
+		err     error
+	)
+
+	if generation == 0 {
+		f.mu.Lock()
+		content, err = os.ReadFile(f.file.Name())
+		f.mu.Unlock()
+	} else {
+		content, err = readRotated(f.file.Name() + "." + strconv.Itoa(generation))
+	}
+
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, errors.New("error reading back file: " + err.Error())
+	}
+
+	var entries []Entry
+
+	for _, line := range bytes.Split(bytes.TrimPrefix(content, f.charset.BOM()), []byte("\n")) {
+		if entry, ok := f.parseEntry(line); ok {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, true, nil
+}
+
+// readRotated returns the content of the rotated file at the path, or of its gzipped version.
+func readRotated(path string) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if !errors.Is(err, os.ErrNotExist) {
+		return content, err
+	}
+
+	file, err := os.Open(path + ".gz")
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return io.ReadAll(gz)
+}
+
+// parseEntry returns the entry of a line written by a JSONEncoder, and whether the line is one.
+func (f *File) parseEntry(line []byte) (Entry, bool) {
+	var je jsonEntry
+	if err := json.Unmarshal(line, &je); err != nil {
+		return Entry{}, false
+	}
+
+	level, ok := levelByName(je.Level)
+	if !ok {
+		return Entry{}, false
+	}
+
+	t, err := parseEntryTime(je.Time, f.layout)
+	if err != nil {
+		return Entry{}, false
+	}
+
+	entry := Entry{
+		Level:     level,
+		Time:      t,
+		Message:   je.Message,
+		Caller:    je.Caller,
+		Function:  je.Function,
+		Component: je.Component,
+		Service:   je.Service,
+		Build:     je.Build,
+		ID:        je.ID,
+		Fields:    Fields(je.Fields),
+		Scope:     je.Scope,
+		Schema:    je.Schema,
+	}
+
+	if entry.Function == "" {
+		entry.Function = "unknown"
+	}
+
+	return entry, true
+}
+
+// parseEntryTime returns the time of an entry rendered by a JSONEncoder in the format, a JSON string, or a number for
+// the epoch formats. The format defaults to TimeFormatDefault.
+func parseEntryTime(raw json.RawMessage, format string) (time.Time, error) {
+	if format == "" {
+		format = TimeFormatDefault
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return time.ParseInLocation(format, s, time.Local)
+	}
+
+	epoch, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	switch format {
+	case TimeFormatEpochSeconds:
+		return time.Unix(epoch, 0), nil
+	case TimeFormatEpochMillis:
+		return time.UnixMilli(epoch), nil
+	case TimeFormatEpochNanos:
+		return time.Unix(0, epoch), nil
+	default:
+		return time.Time{}, errors.New("epoch time with the format " + format)
+	}
+}
+
+// UnmarshalJSON decodes the fields of a JSON object, in order.
+func (f *jsonFields) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		key, _ := token.(string)
+
+		var value any
+		if err = dec.Decode(&value); err != nil {
+			return err
+		}
+
+		*f = append(*f, F(key, value))
+	}
+
+	return nil
+}
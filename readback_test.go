@@ -0,0 +1,120 @@
+package loggo_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/loggo"
+)
+
+// writeGeneration logs the messages in JSON to the writer, one minute apart from the start.
+func writeGeneration(t *testing.T, w io.Writer, start time.Time, messages ...string) {
+	t.Helper()
+
+	now := start
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithFormat(loggo.FormatJSON),
+		loggo.WithTimeFormat(loggo.TimeFormatRFC3339), loggo.WithTimeProvider(func() time.Time { return now }))
+
+	for _, message := range messages {
+		logger.InfoKV(message, "user", 42)
+		now = now.Add(time.Minute)
+	}
+}
+
+func TestFile_readBack(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+	start := time.Date(2022, 1, 25, 0, 0, 0, 0, time.UTC)
+
+	rotated, err := os.Create(path + ".2.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gz, err := loggo.NewGzipWriter(rotated)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writeGeneration(t, gz, start, "a", "b")
+	_ = gz.Close()
+	_ = rotated.Close()
+
+	rotated, err = os.Create(path + ".1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writeGeneration(t, rotated, start.Add(2*time.Minute), "c", "d")
+	_ = rotated.Close()
+
+	file, err := loggo.OpenFile(path, loggo.WithReadTimeFormat(loggo.TimeFormatRFC3339))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	writeGeneration(t, file, start.Add(4*time.Minute), "e")
+	_, _ = file.Write([]byte("not json\n"))
+	writeGeneration(t, file, start.Add(5*time.Minute), "f")
+
+	type testCase struct {
+		name string
+		read func() ([]loggo.Entry, error)
+		want string
+	}
+
+	testCases := []testCase{
+		{name: "tail current", read: func() ([]loggo.Entry, error) { return file.Tail(2) }, want: "e f"},
+		{name: "tail rotated", read: func() ([]loggo.Entry, error) { return file.Tail(3) }, want: "d e f"},
+		{name: "tail gzipped", read: func() ([]loggo.Entry, error) { return file.Tail(10) }, want: "a b c d e f"},
+		{name: "tail none", read: func() ([]loggo.Entry, error) { return file.Tail(0) }, want: ""},
+		{
+			name: "range",
+			read: func() ([]loggo.Entry, error) {
+				return file.ReadRange(start.Add(time.Minute), start.Add(5*time.Minute))
+			},
+			want: "b c d e",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			entries, err := tc.read()
+			if err != nil {
+				t.Fatalf("read error = %v", err)
+			}
+
+			var messages []string
+			for _, entry := range entries {
+				messages = append(messages, entry.Message)
+			}
+
+			if got := strings.Join(messages, " "); got != tc.want {
+				t.Errorf("messages = %q, want %q", got, tc.want)
+			}
+		})
+	}
+
+	entries, _ := file.Tail(1)
+	if got := entries[0]; got.Level != loggo.LevelInfo || !got.Time.Equal(start.Add(5*time.Minute)) ||
+		got.Fields.String() != "user=42" {
+		t.Errorf("entry = %+v, want an info entry at 00:05 with user=42", got)
+	}
+}
+
+func TestFile_readBack_charset(t *testing.T) {
+	file, err := loggo.OpenFile(filepath.Join(t.TempDir(), "app.log"), loggo.WithCharset(loggo.CharsetLatin1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	if _, err = file.Tail(1); err == nil {
+		t.Error("Tail() error = nil, want an error")
+	}
+}
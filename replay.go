@@ -0,0 +1,69 @@
+package loggo
+
+// Replay logs the given entries to a Logger, preserving their original level, time and caller. The entries go through
+// the pre-hooks, current threshold, see GetThreshold, maximum size, location, filters, output, sinks and post-hooks of
+// the Logger, as if they were logged by it at their original time. It stops at the first entry that cannot be logged.
+//
+// Parameters:
+//   - entries: The entries to replay, e.g. the ones captured by a loggotest.Observer.
+//   - dst: The Logger to replay the entries to.
+//
+// Returns:
+//   - An error if an entry could not be logged, nil otherwise.
+//
+// Example:
+//
+//	observer := loggotest.NewObserver()
+//	buffered := loggo.New(loggo.LevelDebug, loggo.WithOutput(io.Discard), loggo.WithSink(observer))
+//	// ... log to buffered, then reconfigure ...
+//	err := loggo.Replay(observer.Entries(), loggo.New(loggo.LevelInfo))
+func Replay(entries []Entry, dst *Logger) error {
+	for _, entry := range entries {
+		if err := dst.replay(entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replay logs a single entry, preserving its original level, time and caller.
+func (l *Logger) replay(entry Entry) error {
+	if l.off() {
+		return nil
+	}
+
+	message := entry.Message
+
+	for _, hook := range l.preHooks {
+		hook(l, &message)
+	}
+
+	if entry.Level.Rank() < l.GetThreshold().Rank() {
+		return nil
+	}
+
+	entry.Message = truncateString(message, l.maxSize)
+	if entry.Context == nil {
+		entry.Context = l.Context
+	}
+
+	if l.location != nil {
+		entry.Time = entry.Time.In(l.location)
+	}
+
+	entry, ok := l.accept(entry)
+	if !ok {
+		return nil
+	}
+
+	if err := l.write(entry); err != nil {
+		return err
+	}
+
+	for _, hook := range l.postHooks {
+		hook(l, &message)
+	}
+
+	return nil
+}
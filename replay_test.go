@@ -0,0 +1,58 @@
+package loggo_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+func TestReplay(t *testing.T) {
+	observer := loggotest.NewObserver()
+	src, clock := loggotest.New(loggo.LevelDebug, loggo.WithOutput(io.Discard), loggo.WithSink(observer), loggo.WithCallerProvider(okCallerProvider))
+
+	src.Debug("first")
+	clock.Advance(time.Hour)
+	src.Warn("second")
+
+	w := &strings.Builder{}
+	dst := loggo.New(
+		loggo.LevelInfo,
+		loggo.WithOutput(w),
+		loggo.WithTimeProvider(time.Now),
+		loggo.WithTemplate("{{.Time}} {{.Caller}} [{{.Level}}]: {{.Message}}"),
+		loggo.WithPreHook(func(l *loggo.Logger, msg *string) { *msg = strings.ToUpper(*msg) }),
+	)
+
+	if err := loggo.Replay(observer.Entries(), dst); err != nil {
+		t.Fatalf("Replay() error = %v", err)
+	}
+
+	if want := "2022-01-25 01:00:00 file:1 [WARN]: SECOND\n"; w.String() != want {
+		t.Errorf("Replay() = %q, want %q", w.String(), want)
+	}
+}
+
+func TestReplay_error(t *testing.T) {
+	entries := []loggo.Entry{{Level: loggo.LevelInfo, Time: fakeNow(), Message: "first"}}
+	dst := loggo.New(loggo.LevelInfo, loggo.WithTemplate("{{.Level"))
+
+	if err := loggo.Replay(entries, dst); err == nil {
+		t.Error("Replay() error = nil, want an error")
+	}
+}
+
+func ExampleReplay() {
+	entries := []loggo.Entry{
+		{Level: loggo.LevelInfo, Time: fakeNow(), Message: "This is an info log message"},
+		{Level: loggo.LevelError, Time: fakeNow().Add(time.Minute), Message: "This is an error log message"},
+	}
+
+	logger := loggo.New(loggo.LevelInfo, loggo.WithLocation(time.FixedZone("BRT", -3*60*60)))
+	_ = loggo.Replay(entries, logger)
+	// Output: 2022-01-24 21:00:00 [ INFO]: This is an info log message
+	// 2022-01-24 21:01:00 [ERROR]: This is an error log message
+}
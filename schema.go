@@ -0,0 +1,65 @@
+package loggo
+
+import (
+	"errors"
+)
+
+// Migration upgrades the entries of a schema version to the next one, see Migrate.
+type Migration struct {
+	From    string            // Schema version of the entries upgraded, empty for the unversioned entries
+	To      string            // Schema version of the upgraded entries
+	Upgrade func(Entry) Entry // Function upgrading an entry, such as renaming its fields
+}
+
+// Migrate upgrades a parsed entry, such as one read from an archive with ReadDeadLetters, to the target schema
+// version, applying the migrations from its version in turn, so long-lived archives stay processable as the format of
+// the entries evolves.
+//
+// Parameters:
+//   - entry: The entry to upgrade.
+//   - target: The schema version to upgrade the entry to.
+//   - migrations: The migrations between the schema versions, in any order.
+//
+// Returns:
+//   - The entry upgraded to the target version.
+//   - An error if no migration upgrades a version of the entry before the target one, nil otherwise.
+//
+// Example:
+//
+//	renameUser := loggo.Migration{From: "1", To: "2", Upgrade: func(e loggo.Entry) loggo.Entry {
+//		for i, f := range e.Fields {
+//			if f.Key == "uid" {
+//				e.Fields[i].Key = "user_id"
+//			}
+//		}
+//		return e
+//	}}
+//	entry, err := loggo.Migrate(entry, "2", renameUser)
+func Migrate(entry Entry, target string, migrations ...Migration) (Entry, error) {
+	for steps := 0; entry.Schema != target; steps++ {
+		m, ok := findMigration(migrations, entry.Schema)
+		if !ok {
+			return entry, errors.New("error migrating entry: no migration from version " + entry.Schema)
+		}
+
+		if steps == len(migrations) {
+			return entry, errors.New("error migrating entry: migration cycle at version " + entry.Schema)
+		}
+
+		entry = m.Upgrade(entry)
+		entry.Schema = m.To
+	}
+
+	return entry, nil
+}
+
+// findMigration returns the migration upgrading the entries of the version.
+func findMigration(migrations []Migration, from string) (Migration, bool) {
+	for _, m := range migrations {
+		if m.From == from {
+			return m, true
+		}
+	}
+
+	return Migration{}, false
+}
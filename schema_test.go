@@ -0,0 +1,107 @@
+package loggo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+func TestWithSchemaVersion(t *testing.T) {
+	w := &strings.Builder{}
+	observer := loggotest.NewObserver()
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTimeProvider(fakeNow),
+		loggo.WithFormat(loggo.FormatJSON), loggo.WithSchemaVersion("2"), loggo.WithSink(observer),
+		loggo.WithCallerProvider(func() (uintptr, string, int, bool) { return 0, "app.go", 7, true }))
+
+	logger.Info("started")
+
+	want := `{"time":"2022-01-25 00:00:00","level":"INFO","message":"started","caller":"app.go:7","schema":"2"}` + "\n"
+	if w.String() != want {
+		t.Errorf("output = %s, want %s", w.String(), want)
+	}
+
+	if got := observer.Entries()[0].Schema; got != "2" {
+		t.Errorf("Entry.Schema = %q, want %q", got, "2")
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	renameUser := loggo.Migration{From: "", To: "1", Upgrade: func(e loggo.Entry) loggo.Entry {
+		for i, f := range e.Fields {
+			if f.Key == "uid" {
+				e.Fields[i].Key = "user_id"
+			}
+		}
+
+		return e
+	}}
+	upperMessage := loggo.Migration{From: "1", To: "2", Upgrade: func(e loggo.Entry) loggo.Entry {
+		e.Message = strings.ToUpper(e.Message)
+
+		return e
+	}}
+	back := loggo.Migration{From: "2", To: "1", Upgrade: func(e loggo.Entry) loggo.Entry { return e }}
+
+	type testCase struct {
+		name       string
+		entry      loggo.Entry
+		target     string
+		migrations []loggo.Migration
+		want       string
+		wantErr    string
+	}
+
+	testCases := []testCase{
+		{
+			name:       "unversioned",
+			entry:      loggo.Entry{Message: "login", Fields: loggo.Fields{loggo.F("uid", 42)}},
+			target:     "2",
+			migrations: []loggo.Migration{upperMessage, renameUser},
+			want:       "2 LOGIN user_id=42",
+		},
+		{
+			name:       "current",
+			entry:      loggo.Entry{Schema: "2", Message: "login"},
+			target:     "2",
+			migrations: []loggo.Migration{upperMessage},
+			want:       "2 login ",
+		},
+		{
+			name:       "missing migration",
+			entry:      loggo.Entry{Schema: "1", Message: "login"},
+			target:     "3",
+			migrations: []loggo.Migration{upperMessage},
+			wantErr:    "error migrating entry: no migration from version 2",
+		},
+		{
+			name:       "cycle",
+			entry:      loggo.Entry{Schema: "1", Message: "login"},
+			target:     "3",
+			migrations: []loggo.Migration{upperMessage, back},
+			wantErr:    "error migrating entry: migration cycle at version 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := loggo.Migrate(tc.entry, tc.target, tc.migrations...)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Errorf("Migrate() error = %v, want %q", err, tc.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Migrate() error = %v", err)
+			}
+
+			if s := got.Schema + " " + got.Message + " " + got.Fields.String(); s != tc.want {
+				t.Errorf("Migrate() = %q, want %q", s, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package loggo
+
+// Service describes the service emitting the log entries, following the service.name, service.version and
+// service.environment conventions of OpenTelemetry and ECS.
+type Service struct {
+	Name        string `json:"name,omitempty"`        // Name of the service, e.g. "checkout"
+	Version     string `json:"version,omitempty"`     // Version of the service, e.g. "1.4.2"
+	Environment string `json:"environment,omitempty"` // Deployment environment of the service, e.g. "production"
+}
@@ -0,0 +1,79 @@
+package loggo
+
+// Severity is the severity of a log level in an external system, with its number and its name.
+type Severity struct {
+	Number int    // Numeric severity, e.g. 13 for an OpenTelemetry warning
+	Name   string // Name of the severity, e.g. "WARNING" for Google Cloud Logging
+}
+
+// SeverityProfile maps the log levels to the severities of an external system, so the encoders and sinks reporting
+// numeric severities agree on them, see WithSeverityProfile. SeveritySyslog, SeverityOTel, SeverityGoogleCloud and
+// SeverityPagerDuty are the built-in profiles, mapping the custom levels to the severity of the highest built-in level
+// ranking below them, and a custom profile is a function of the same signature.
+type SeverityProfile func(level Level) Severity
+
+// SeveritySyslog is the SeverityProfile of the syslog priorities, from 7 (debug) for LevelTrace and LevelDebug to
+// 2 (crit) for LevelPanic and LevelFatal, also used by systemd.
+func SeveritySyslog(level Level) Severity {
+	return [...]Severity{
+		LevelTrace: {Number: 7, Name: "debug"},
+		LevelDebug: {Number: 7, Name: "debug"},
+		LevelInfo:  {Number: 6, Name: "info"},
+		LevelWarn:  {Number: 4, Name: "warning"},
+		LevelError: {Number: 3, Name: "err"},
+		LevelPanic: {Number: 2, Name: "crit"},
+		LevelFatal: {Number: 2, Name: "crit"},
+	}[level.base()]
+}
+
+// SeverityOTel is the SeverityProfile of the OpenTelemetry log data model, mapping each level to the first
+// SeverityNumber of its range, from 1 (TRACE) for LevelTrace to 21 (FATAL) for LevelFatal, except LevelPanic mapped to
+// the last one of the error range, 20 (ERROR4).
+func SeverityOTel(level Level) Severity {
+	return [...]Severity{
+		LevelTrace: {Number: 1, Name: "TRACE"},
+		LevelDebug: {Number: 5, Name: "DEBUG"},
+		LevelInfo:  {Number: 9, Name: "INFO"},
+		LevelWarn:  {Number: 13, Name: "WARN"},
+		LevelError: {Number: 17, Name: "ERROR"},
+		LevelPanic: {Number: 20, Name: "ERROR4"},
+		LevelFatal: {Number: 21, Name: "FATAL"},
+	}[level.base()]
+}
+
+// SeverityGoogleCloud is the SeverityProfile of Google Cloud Logging, from 100 (DEBUG) for LevelTrace and LevelDebug
+// to 600 (CRITICAL) for LevelPanic and LevelFatal.
+func SeverityGoogleCloud(level Level) Severity {
+	return [...]Severity{
+		LevelTrace: {Number: 100, Name: "DEBUG"},
+		LevelDebug: {Number: 100, Name: "DEBUG"},
+		LevelInfo:  {Number: 200, Name: "INFO"},
+		LevelWarn:  {Number: 400, Name: "WARNING"},
+		LevelError: {Number: 500, Name: "ERROR"},
+		LevelPanic: {Number: 600, Name: "CRITICAL"},
+		LevelFatal: {Number: 600, Name: "CRITICAL"},
+	}[level.base()]
+}
+
+// SeverityPagerDuty is the SeverityProfile of the PagerDuty events, which only have named severities, numbered by
+// their rank from 1 (info) for LevelTrace, LevelDebug and LevelInfo to 4 (critical) for LevelPanic and LevelFatal.
+func SeverityPagerDuty(level Level) Severity {
+	return [...]Severity{
+		LevelTrace: {Number: 1, Name: "info"},
+		LevelDebug: {Number: 1, Name: "info"},
+		LevelInfo:  {Number: 1, Name: "info"},
+		LevelWarn:  {Number: 2, Name: "warning"},
+		LevelError: {Number: 3, Name: "error"},
+		LevelPanic: {Number: 4, Name: "critical"},
+		LevelFatal: {Number: 4, Name: "critical"},
+	}[level.base()]
+}
+
+// severityOf returns the severity of the level in the profile, or in SeveritySyslog if the profile is nil.
+func severityOf(profile SeverityProfile, level Level) Severity {
+	if profile == nil {
+		profile = SeveritySyslog
+	}
+
+	return profile(level)
+}
@@ -0,0 +1,104 @@
+package loggo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestSeverityProfiles(t *testing.T) {
+	type testCase struct {
+		name    string
+		profile loggo.SeverityProfile
+		want    []loggo.Severity
+	}
+
+	testCases := []testCase{
+		{
+			name:    "syslog",
+			profile: loggo.SeveritySyslog,
+			want: []loggo.Severity{{7, "debug"}, {7, "debug"}, {6, "info"}, {4, "warning"}, {3, "err"}, {2, "crit"},
+				{2, "crit"}},
+		},
+		{
+			name:    "otel",
+			profile: loggo.SeverityOTel,
+			want: []loggo.Severity{{1, "TRACE"}, {5, "DEBUG"}, {9, "INFO"}, {13, "WARN"}, {17, "ERROR"}, {20, "ERROR4"},
+				{21, "FATAL"}},
+		},
+		{
+			name:    "google cloud",
+			profile: loggo.SeverityGoogleCloud,
+			want: []loggo.Severity{{100, "DEBUG"}, {100, "DEBUG"}, {200, "INFO"}, {400, "WARNING"}, {500, "ERROR"},
+				{600, "CRITICAL"}, {600, "CRITICAL"}},
+		},
+		{
+			name:    "pagerduty",
+			profile: loggo.SeverityPagerDuty,
+			want: []loggo.Severity{{1, "info"}, {1, "info"}, {1, "info"}, {2, "warning"}, {3, "error"}, {4, "critical"},
+				{4, "critical"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for level := loggo.LevelTrace; level <= loggo.LevelFatal; level++ {
+				if got := tc.profile(level); got != tc.want[level] {
+					t.Errorf("profile(%s) = %v, want %v", level, got, tc.want[level])
+				}
+			}
+		})
+	}
+}
+
+func TestWithSeverityProfile(t *testing.T) {
+	type testCase struct {
+		name    string
+		options []loggo.Option
+		want    string
+	}
+
+	testCases := []testCase{
+		{
+			name:    "template default",
+			options: []loggo.Option{loggo.WithTemplate("{{.Severity.Number}} {{.Severity.Name}} {{.Message}}")},
+			want:    "4 warning disk full\n",
+		},
+		{
+			name: "template",
+			options: []loggo.Option{loggo.WithSeverityProfile(loggo.SeverityOTel),
+				loggo.WithTemplate("{{.Severity.Number}} {{.Severity.Name}} {{.Message}}")},
+			want: "13 WARN disk full\n",
+		},
+		{
+			name:    "json",
+			options: []loggo.Option{loggo.WithSeverityProfile(loggo.SeverityGoogleCloud), loggo.WithFormat(loggo.FormatJSON)},
+			want: `{"time":"2022-01-25 00:00:00","level":"WARN","severity":"WARNING","severity_number":400,` +
+				`"message":"disk full","caller":"app.go:7"}` + "\n",
+		},
+		{
+			name:    "logfmt",
+			options: []loggo.Option{loggo.WithSeverityProfile(loggo.SeverityPagerDuty), loggo.WithFormat(loggo.FormatLogfmt)},
+			want:    `time="2022-01-25 00:00:00" level=WARN severity=warning severity_number=2 msg="disk full" caller=app.go:7` + "\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			options := append([]loggo.Option{
+				loggo.WithOutput(w),
+				loggo.WithTimeProvider(fakeNow),
+				loggo.WithCallerProvider(func() (uintptr, string, int, bool) { return 0, "app.go", 7, true }),
+			}, tc.options...)
+			logger := loggo.New(loggo.LevelInfo, options...)
+
+			logger.Warn("disk full")
+
+			if w.String() != tc.want {
+				t.Errorf("output = %q, want %q", w.String(), tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package loggo
+
+import (
+	"sync"
+)
+
+// Shadow is a Sink duplicating a sample of the entries to a shadow pipeline: another Logger, with its own format,
+// outputs and sinks, such as a new encoder and collector, to validate a logging migration in production before cutting
+// over. The entries are replayed to the shadow Logger preserving their level, time and caller, and the failures of the
+// shadow pipeline never fail the Logger writing to the Shadow. It is safe for concurrent use.
+//
+// The shadow Logger must not be derived from the Logger writing to the Shadow, as they would share the output lock.
+type Shadow struct {
+	mu     sync.Mutex
+	logger *Logger
+	rate   float64
+	credit float64
+	sent   uint64
+	failed uint64
+}
+
+// NewShadow returns a Shadow duplicating the given proportion of the entries to the shadow Logger. The sample is
+// deterministic: with a rate of 0.1, every tenth entry is duplicated.
+//
+// Parameters:
+//   - shadow: The Logger of the shadow pipeline.
+//   - rate: The proportion of the entries duplicated, from 0 for none to 1 for all of them.
+//
+// Returns:
+//   - A pointer to the Shadow.
+//
+// Example:
+//
+//	shadow := loggo.New(loggo.LevelDebug, loggo.WithFormat(loggo.FormatJSON), loggo.WithSink(newCollector))
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithSink(loggo.NewShadow(shadow, 0.05)))
+func NewShadow(shadow *Logger, rate float64) *Shadow {
+	return &Shadow{logger: shadow, rate: min(max(rate, 0), 1)}
+}
+
+// WriteEntry replays the entry to the shadow Logger if it is sampled. It never returns an error, the failures of the
+// shadow pipeline being counted instead, see Stats.
+func (s *Shadow) WriteEntry(entry Entry) error {
+	s.mu.Lock()
+	s.credit += s.rate
+	sampled := s.credit >= 1
+	if sampled {
+		s.credit--
+	}
+	s.mu.Unlock()
+
+	if !sampled {
+		return nil
+	}
+
+	err := s.logger.replay(entry)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sent++
+	if err != nil {
+		s.failed++
+	}
+
+	return nil
+}
+
+// Stats returns the number of entries duplicated to the shadow pipeline, and how many of them it failed to log.
+func (s *Shadow) Stats() (sent, failed uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.sent, s.failed
+}
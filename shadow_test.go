@@ -0,0 +1,62 @@
+package loggo_test
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+func TestShadow(t *testing.T) {
+	type testCase struct {
+		name       string
+		rate       float64
+		shadowOpts []loggo.Option
+		want       []string
+		wantFailed uint64
+	}
+
+	testCases := []testCase{
+		{name: "none", rate: 0},
+		{name: "sample", rate: 0.25, want: []string{"[INFO] entry 3", "[INFO] entry 7"}},
+		{
+			name:       "all",
+			rate:       2,
+			want:       []string{"[INFO] entry 0", "[INFO] entry 1", "[INFO] entry 2", "[INFO] entry 3"},
+			shadowOpts: []loggo.Option{loggo.WithFilter(func(e loggo.Entry) bool { return e.Message < "entry 4" })},
+		},
+		{
+			name:       "failing shadow",
+			rate:       0.5,
+			want:       []string{"[INFO] entry 1", "[INFO] entry 3", "[INFO] entry 5", "[INFO] entry 7"},
+			shadowOpts: []loggo.Option{loggo.WithSink(&failAfterSink{limit: 2})},
+			wantFailed: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			observer := loggotest.NewObserver()
+			shadowLogger := loggo.New(loggo.LevelInfo,
+				append([]loggo.Option{loggo.WithOutput(&loggotest.Recorder{}), loggo.WithSink(observer)}, tc.shadowOpts...)...)
+			shadow := loggo.NewShadow(shadowLogger, tc.rate)
+
+			logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(&loggotest.Recorder{}), loggo.WithSink(shadow))
+			for i := range 8 {
+				if err := logger.LogE(loggo.LevelInfo, "entry "+strconv.Itoa(i)); err != nil {
+					t.Errorf("Logger.LogE() error = %v", err)
+				}
+			}
+
+			if got := observer.All(); !reflect.DeepEqual(got, tc.want) && len(got)+len(tc.want) > 0 {
+				t.Errorf("shadow entries = %v, want %v", got, tc.want)
+			}
+
+			if sent, failed := shadow.Stats(); failed != tc.wantFailed {
+				t.Errorf("Shadow.Stats() = %d, %d, want %d failed", sent, failed, tc.wantFailed)
+			}
+		})
+	}
+}
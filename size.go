@@ -0,0 +1,56 @@
+package loggo
+
+// sizeBounds are the upper bounds, in bytes, of the buckets of the size histograms, from 64 B to 1 MiB.
+var sizeBounds = []int{64, 256, 1 << 10, 4 << 10, 16 << 10, 64 << 10, 256 << 10, 1 << 20}
+
+// SizeHistogram is a histogram of the sizes of the rendered entries of a level, as reported in the Health of a Logger,
+// to detect code paths starting to log huge payloads before they take down a collector.
+type SizeHistogram struct {
+	Bounds []int    // Upper bounds, in bytes, of the buckets, inclusive
+	Counts []uint64 // Number of entries per bucket, not cumulative, followed by the entries larger than the last bound
+	Count  uint64   // Number of entries
+	Sum    uint64   // Total size of the entries, in bytes
+}
+
+// observe records the size of a rendered entry in the histogram.
+func (h *SizeHistogram) observe(size int) {
+	if h.Counts == nil {
+		h.Bounds = sizeBounds
+		h.Counts = make([]uint64, len(sizeBounds)+1)
+	}
+
+	i := 0
+	for i < len(h.Bounds) && size > h.Bounds[i] {
+		i++
+	}
+
+	h.Counts[i]++
+	h.Count++
+	h.Sum += uint64(size)
+}
+
+// clone returns a copy of the histogram not sharing its counts.
+func (h SizeHistogram) clone() SizeHistogram {
+	h.Counts = append([]uint64(nil), h.Counts...)
+
+	return h
+}
+
+// recordSizes records the sizes of the rendered entries, ending at the given offsets, nil for a single entry.
+// The lock must be held.
+func (h *health) recordSizes(rendered []byte, ends []int, entries []Entry) {
+	start := 0
+
+	for i, entry := range entries {
+		end := len(rendered)
+		if ends != nil {
+			end = ends[i]
+		}
+
+		histogram := h.sizes[entry.Level]
+		histogram.observe(end - start)
+		h.sizes[entry.Level] = histogram
+
+		start = end
+	}
+}
@@ -0,0 +1,157 @@
+package loggo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime"
+)
+
+// slogHandler is a slog.Handler logging the records to a Logger.
+type slogHandler struct {
+	logger *Logger
+	fields Fields // Fields of the attributes added with WithAttrs
+	prefix string // Prefix of the keys of the attributes, from the groups opened with WithGroup, e.g. "request."
+}
+
+// NewSlogHandler returns a slog.Handler logging the records to the Logger, so it can back the log/slog front-end
+// while keeping its threshold, hooks, filters, sinks and format. The slog levels are mapped to the built-in levels:
+//
+//   - Below slog.LevelDebug: LevelTrace.
+//   - From slog.LevelDebug: LevelDebug.
+//   - From slog.LevelInfo: LevelInfo.
+//   - From slog.LevelWarn: LevelWarn.
+//   - From slog.LevelError: LevelError.
+//   - From slog.LevelError+4: LevelFatal, logged without exiting, as the Log method does.
+//
+// No slog level maps to LevelPanic, nor to a custom level. The attributes are logged as fields, with the keys of the
+// attributes in groups prefixed by the group names, e.g. "request.method". The time and the caller of the records are
+// preserved, and the context passed to the slog functions becomes the context of the entry, rendered by
+// {{ctx "name"}} in the template.
+//
+// Parameters:
+//   - logger: The Logger to log the records to.
+//
+// Returns:
+//   - The slog.Handler.
+//
+// Example:
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithFormat(loggo.FormatJSON))
+//	slog.SetDefault(slog.New(loggo.NewSlogHandler(logger)))
+//	slog.Info("handled", "user", 42)
+func NewSlogHandler(logger *Logger) slog.Handler {
+	return &slogHandler{logger: logger}
+}
+
+// slogLevel returns the Level of a slog level.
+func slogLevel(level slog.Level) Level {
+	switch {
+	case level < slog.LevelDebug:
+		return LevelTrace
+	case level < slog.LevelInfo:
+		return LevelDebug
+	case level < slog.LevelWarn:
+		return LevelInfo
+	case level < slog.LevelError:
+		return LevelWarn
+	case level < slog.LevelError+4:
+		return LevelError
+	default:
+		return LevelFatal
+	}
+}
+
+// Enabled reports whether the Logger may log the records of the level.
+func (h *slogHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return !h.logger.off() && !h.logger.discards(slogLevel(level))
+}
+
+// Handle logs the record to the Logger, at its time and caller, with its context as the context of the entry.
+func (h *slogHandler) Handle(ctx context.Context, record slog.Record) error {
+	l := h.logger
+	if ctx == nil {
+		ctx = l.Context
+	}
+
+	level, message, ok := l.admit(ctx, slogLevel(record.Level), text(record.Message))
+	if !ok {
+		return nil
+	}
+
+	fields := h.fields[:len(h.fields):len(h.fields)]
+	record.Attrs(func(attr slog.Attr) bool {
+		fields = appendAttr(fields, h.prefix, attr)
+
+		return true
+	})
+
+	return l.logEntry(newEntryAt(level, message, slogOrigin(l, ctx, record), l, fields...), message)
+}
+
+// slogOrigin returns the origin of the entry of a record: its caller, unknown without a PC, its time, the current
+// time of the Logger if zero, and the context.
+func slogOrigin(l *Logger, ctx context.Context, record slog.Record) entryOrigin {
+	origin := entryOrigin{caller: "unknown", time: record.Time, ctx: ctx}
+	if record.PC != 0 {
+		frame, _ := runtime.CallersFrames([]uintptr{record.PC}).Next()
+		origin.caller = fmt.Sprintf("%s:%d", l.callerFormat.path(frame.File), frame.Line)
+		origin.function = frame.Function
+	}
+
+	switch {
+	case origin.time.IsZero():
+		origin.time = getTime(l)
+	case l.location != nil:
+		origin.time = origin.time.In(l.location)
+	}
+
+	return origin
+}
+
+// WithAttrs returns a copy of the handler logging the attributes with every record.
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	c := *h
+	c.fields = h.fields[:len(h.fields):len(h.fields)]
+
+	for _, attr := range attrs {
+		c.fields = appendAttr(c.fields, h.prefix, attr)
+	}
+
+	return &c
+}
+
+// WithGroup returns a copy of the handler prefixing the keys of the attributes that follow with the group name.
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	c := *h
+	c.prefix = h.prefix + name + "."
+
+	return &c
+}
+
+// appendAttr appends the attribute to the fields, with its key prefixed, flattening groups into the keys of their
+// attributes. Empty attributes and groups are skipped, and groups without a key are inlined, as slog.Handler requires.
+func appendAttr(fields Fields, prefix string, attr slog.Attr) Fields {
+	attr.Value = attr.Value.Resolve()
+	if attr.Equal(slog.Attr{}) {
+		return fields
+	}
+
+	if attr.Value.Kind() != slog.KindGroup {
+		return append(fields, F(prefix+attr.Key, attr.Value.Any()))
+	}
+
+	if attr.Key != "" {
+		prefix += attr.Key + "."
+	}
+
+	for _, a := range attr.Value.Group() {
+		fields = appendAttr(fields, prefix, a)
+	}
+
+	return fields
+}
@@ -0,0 +1,139 @@
+package loggo_test
+
+import (
+	"context"
+	"log/slog"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestNewSlogHandler(t *testing.T) {
+	type testCase struct {
+		name string
+		log  func(l *slog.Logger)
+		want string
+	}
+
+	testCases := []testCase{
+		{
+			name: "levels",
+			log: func(l *slog.Logger) {
+				l.Debug("discarded")
+				l.Info("info")
+				l.Warn("warn")
+				l.Error("error")
+				l.Log(context.Background(), slog.LevelError+4, "fatal")
+				l.Log(context.Background(), slog.LevelInfo+2, "info+2")
+			},
+			want: "INFO info \nWARN warn \nERROR error \nFATAL fatal \nINFO info+2 \n",
+		},
+		{
+			name: "attributes",
+			log: func(l *slog.Logger) {
+				l.With("service", "api").Info("handled", "user", 42, slog.Duration("took", time.Second))
+			},
+			want: "INFO handled service=api user=42 took=1s\n",
+		},
+		{
+			name: "groups",
+			log: func(l *slog.Logger) {
+				l.WithGroup("request").With("id", 7).Info("handled",
+					slog.Group("http", "method", "GET", "status", 200), slog.Group("empty"), slog.Group("", "inline", true))
+			},
+			want: "INFO handled request.id=7 request.http.method=GET request.http.status=200 request.inline=true\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w),
+				loggo.WithTemplate("{{.Level}} {{.Message}} {{.Fields}}"))
+
+			tc.log(slog.New(loggo.NewSlogHandler(logger)))
+
+			if w.String() != tc.want {
+				t.Errorf("output = %q, want %q", w.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestNewSlogHandler_record(t *testing.T) {
+	type ctxKey struct{}
+
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Time}} {{.Caller}} {{.Fields}}"),
+		loggo.WithCallerFormat(loggo.CallerFormatBase),
+		loggo.WithContextFields(func(ctx context.Context) loggo.Fields {
+			return loggo.Fields{loggo.F("tenant", ctx.Value(ctxKey{}))}
+		}))
+	handler := loggo.NewSlogHandler(logger)
+
+	pc, _, line, _ := runtime.Caller(0)
+	record := slog.NewRecord(time.Date(2022, 1, 25, 10, 0, 0, 0, time.UTC), slog.LevelInfo, "handled", pc)
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, "acme")
+	if err := handler.Handle(ctx, record); err != nil {
+		t.Errorf("Handle() error = %v", err)
+	}
+
+	want := "2022-01-25 10:00:00 slog_test.go:" + strconv.Itoa(line) + " tenant=acme\n"
+	if w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+
+	if handler.Enabled(ctx, slog.LevelDebug) || !handler.Enabled(ctx, slog.LevelWarn) {
+		t.Error("Enabled() does not follow the Threshold")
+	}
+}
+
+func TestNewSlogHandler_unknownCaller(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Caller}} {{.Message}}"))
+
+	record := slog.NewRecord(time.Time{}, slog.LevelInfo, "handled", 0)
+	if err := loggo.NewSlogHandler(logger).Handle(context.Background(), record); err != nil {
+		t.Errorf("Handle() error = %v", err)
+	}
+
+	if want := "unknown handled\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
+
+func TestNewSlogHandler_trace(t *testing.T) {
+	ctx := context.Background()
+	debug := loggo.NewSlogHandler(loggo.New(loggo.LevelDebug, loggo.WithOutput(&strings.Builder{})))
+	trace := loggo.NewSlogHandler(loggo.New(loggo.LevelTrace, loggo.WithOutput(&strings.Builder{})))
+
+	if debug.Enabled(ctx, slog.LevelDebug-1) || !debug.Enabled(ctx, slog.LevelDebug) {
+		t.Error("Enabled() maps the levels from slog.LevelDebug to LevelDebug")
+	}
+
+	if !trace.Enabled(ctx, slog.LevelDebug-4) {
+		t.Error("Enabled() does not map the levels below slog.LevelDebug to LevelTrace")
+	}
+}
+
+func TestNewSlogHandler_contextTemplate(t *testing.T) {
+	type ridKey struct{}
+
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithContextKey("rid", ridKey{}),
+		loggo.WithTemplate(`rid={{ctx "rid"}} {{.Message}}`))
+	slogger := slog.New(loggo.NewSlogHandler(logger))
+
+	slogger.InfoContext(context.WithValue(context.Background(), ridKey{}, "42"), "via slog")
+	slogger.InfoContext(context.WithValue(context.Background(), ridKey{}, "43"), "again")
+	logger.Info("direct")
+
+	if want := "rid=42 via slog\nrid=43 again\nrid= direct\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
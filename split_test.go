@@ -0,0 +1,74 @@
+package loggo_test
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestWithSplitOutput(t *testing.T) {
+	out, errOut := &strings.Builder{}, &strings.Builder{}
+	logger := loggo.New(loggo.LevelDebug, loggo.WithOutput(out), loggo.WithSplitOutput(loggo.LevelWarn, errOut),
+		loggo.WithTemplate("{{.Level}} {{.Message}}"))
+
+	logger.Debug("debug")
+	logger.Info("info")
+	logger.Warn("warn")
+	logger.Error("error")
+
+	batch := logger.Batch()
+	batch.Info("batch info")
+	batch.Error("batch error")
+	batch.Info("batch info again")
+
+	if err := batch.Flush(); err != nil {
+		t.Fatalf("Batch.Flush() error = %v", err)
+	}
+
+	if want := "DEBUG debug\nINFO info\nINFO batch info\nINFO batch info again\n"; out.String() != want {
+		t.Errorf("output = %q, want %q", out.String(), want)
+	}
+
+	if want := "WARN warn\nERROR error\nERROR batch error\n"; errOut.String() != want {
+		t.Errorf("split output = %q, want %q", errOut.String(), want)
+	}
+}
+
+func TestWithStderrSplit(t *testing.T) {
+	stdout, stderr := os.Stdout, os.Stderr
+	outReader, outWriter, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe() error = %v", err)
+	}
+
+	errReader, errWriter, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe() error = %v", err)
+	}
+
+	os.Stdout, os.Stderr = outWriter, errWriter
+	logger := loggo.New(loggo.LevelTrace, loggo.WithStderrSplit(), loggo.WithTemplate("{{.Level}} {{.Message}}"))
+	os.Stdout, os.Stderr = stdout, stderr
+
+	for _, level := range []loggo.Level{loggo.LevelTrace, loggo.LevelDebug, loggo.LevelInfo, loggo.LevelWarn,
+		loggo.LevelError, loggo.LevelPanic, loggo.LevelFatal} {
+		logger.Log(level, strings.ToLower(level.String()))
+	}
+
+	_ = outWriter.Close()
+	_ = errWriter.Close()
+
+	out, _ := io.ReadAll(outReader)
+	errOut, _ := io.ReadAll(errReader)
+
+	if want := "TRACE trace\nDEBUG debug\nINFO info\n"; string(out) != want {
+		t.Errorf("stdout = %q, want %q", out, want)
+	}
+
+	if want := "WARN warn\nERROR error\nPANIC panic\nFATAL fatal\n"; string(errOut) != want {
+		t.Errorf("stderr = %q, want %q", errOut, want)
+	}
+}
@@ -0,0 +1,277 @@
+package loggo
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// DefaultSpoolMaxSize is the default maximum size, in bytes, of the on-disk spool of a Spool.
+const DefaultSpoolMaxSize = 64 << 20
+
+// spoolFile is the name of the spool file, in the directory of a Spool.
+const spoolFile = "spool.jsonl"
+
+// spoolMinBackoff and spoolMaxBackoff are the bounds of the delay before the spool is replayed again after the wrapped
+// sink failed.
+const (
+	spoolMinBackoff = time.Second
+	spoolMaxBackoff = time.Minute
+)
+
+// Spool is a Sink wrapping another sink, usually sending the entries over the network. When the wrapped sink fails,
+// the entries are spilled to a bounded on-disk spool, and replayed to it in order once it accepts entries again, so an
+// outage of a collector neither loses logs nor grows the memory of the process. It is safe for concurrent use.
+//
+// The spool is replayed before the entries written once the wrapped sink recovers, on Flush, and when a Spool is
+// opened on a directory holding the spool of a previous process. After the wrapped sink fails, the entries written are
+// spooled without trying it for a delay, doubling from one second up to one minute on each consecutive failure, so an
+// outage neither replays the whole spool on every entry nor holds up the loggers.
+type Spool struct {
+	mu      sync.Mutex
+	sink    Sink
+	path    string
+	maxSize int64
+	size    int64
+	count   int
+	clock   Clock
+	backoff time.Duration // Delay before the next replay, doubled on each consecutive failure of the wrapped sink
+	retry   time.Time     // Time of the next replay
+}
+
+// SpoolOption is a function that configures a Spool.
+type SpoolOption func(*Spool)
+
+// NewSpool returns a Spool wrapping the sink, spilling its entries to a spool file in the directory, created if it
+// does not exist.
+//
+// Parameters:
+//   - sink: The Sink to wrap.
+//   - dir: The directory of the spool file.
+//   - options: Variadic options to configure the Spool.
+//
+// Returns:
+//   - A pointer to the Spool.
+//   - An error if the directory could not be created, nil otherwise.
+//
+// Example:
+//
+//	spool, err := loggo.NewSpool(collector, "/var/spool/app")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	logger := loggo.New(loggo.LevelInfo, loggo.WithSink(spool))
+func NewSpool(sink Sink, dir string, options ...SpoolOption) (*Spool, error) {
+	s := &Spool{
+		sink:    sink,
+		path:    filepath.Join(dir, spoolFile),
+		maxSize: DefaultSpoolMaxSize,
+		clock:   systemClock{now: time.Now},
+	}
+
+	for _, option := range options {
+		option(s)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	if content, err := os.ReadFile(s.path); err == nil {
+		s.size = int64(len(content))
+		s.count = bytes.Count(content, []byte("\n"))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_ = s.replay()
+
+	return s, nil
+}
+
+// WithSpoolMaxSize configures the maximum size, in bytes, of the on-disk spool of a Spool. Once it is reached, the
+// entries the wrapped sink fails to receive are dropped. The default size is DefaultSpoolMaxSize.
+//
+// Parameters:
+//   - size: The maximum size of the spool, in bytes.
+//
+// Example:
+//
+//	spool, err := loggo.NewSpool(collector, "/var/spool/app", loggo.WithSpoolMaxSize(16<<20))
+func WithSpoolMaxSize(size int64) SpoolOption {
+	return func(s *Spool) {
+		s.maxSize = size
+	}
+}
+
+// WithSpoolClock configures the Clock timing the delays before the spool of a Spool is replayed again after the
+// wrapped sink failed, so it can be faked in tests.
+//
+// Parameters:
+//   - clock: The Clock to use.
+//
+// Example:
+//
+//	clock := loggotest.NewClock()
+//	spool, err := loggo.NewSpool(collector, t.TempDir(), loggo.WithSpoolClock(clock))
+//	clock.Advance(time.Minute)
+func WithSpoolClock(clock Clock) SpoolOption {
+	return func(s *Spool) {
+		s.clock = clock
+	}
+}
+
+// WriteEntry sends the entry to the wrapped sink, after the spooled entries. If the wrapped sink fails, or failed less
+// than the backoff delay ago, the entry is spooled instead, and an error is only returned if the spool is full or could
+// not be written.
+func (s *Spool) WriteEntry(entry Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.size == 0 || !s.clock.Now().Before(s.retry) {
+		if s.replay() == nil {
+			if err := s.sink.WriteEntry(entry); err == nil {
+				s.backoff = 0
+
+				return nil
+			}
+
+			s.backOff()
+		}
+	}
+
+	return s.spill(entry)
+}
+
+// Flush replays the spooled entries to the wrapped sink.
+//
+// Returns:
+//   - An error if the wrapped sink failed before every spooled entry was replayed, nil otherwise.
+func (s *Spool) Flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.replay()
+}
+
+// Len returns the size, in bytes, of the on-disk spool.
+func (s *Spool) Len() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.size
+}
+
+// QueueDepth returns the number of entries in the on-disk spool, so it is reported in the Health of a Logger.
+func (s *Spool) QueueDepth() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.count
+}
+
+// spill appends the entry to the spool file. The lock must be held.
+func (s *Spool) spill(entry Entry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return errors.New("error spooling entry: " + err.Error())
+	}
+
+	line = append(line, '\n')
+
+	if s.size+int64(len(line)) > s.maxSize {
+		return errors.New("error spooling entry: spool full")
+	}
+
+	file, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return errors.New("error spooling entry: " + err.Error())
+	}
+	defer file.Close()
+
+	if _, err = file.Write(line); err != nil {
+		return errors.New("error spooling entry: " + err.Error())
+	}
+
+	s.size += int64(len(line))
+	s.count++
+
+	return nil
+}
+
+// replay sends the spooled entries to the wrapped sink in order, keeping in the spool file the entries from the first
+// one the sink fails to receive, and delaying the next replay if it fails. The lock must be held.
+func (s *Spool) replay() error {
+	if s.size == 0 {
+		return nil
+	}
+
+	content, err := os.ReadFile(s.path)
+	if err != nil {
+		s.backOff()
+
+		return errors.New("error reading spool: " + err.Error())
+	}
+
+	for start := 0; start < len(content); {
+		line, _, _ := bytes.Cut(content[start:], []byte("\n"))
+
+		var entry Entry
+		if json.Unmarshal(line, &entry) == nil {
+			if err = s.sink.WriteEntry(entry); err != nil {
+				s.backOff()
+
+				return s.truncate(content[start:], err)
+			}
+		}
+
+		start += len(line) + 1
+	}
+
+	if err = os.Remove(s.path); err != nil {
+		s.backOff()
+
+		return errors.New("error removing spool: " + err.Error())
+	}
+
+	s.size = 0
+	s.count = 0
+	s.backoff = 0
+
+	return nil
+}
+
+// backOff delays the next replay of the spool, doubling the delay since the last success. The lock must be held.
+func (s *Spool) backOff() {
+	s.backoff = min(max(2*s.backoff, spoolMinBackoff), spoolMaxBackoff)
+	s.retry = s.clock.Now().Add(s.backoff)
+}
+
+// truncate rewrites the spool file with the remaining content, returning the error of the wrapped sink.
+// The lock must be held.
+func (s *Spool) truncate(remaining []byte, sinkErr error) error {
+	if int64(len(remaining)) == s.size {
+		return sinkErr
+	}
+
+	tmp := s.path + ".tmp"
+
+	if err := os.WriteFile(tmp, remaining, 0o644); err != nil {
+		return errors.New("error rewriting spool: " + err.Error())
+	}
+
+	if err := os.Rename(tmp, s.path); err != nil {
+		return errors.New("error rewriting spool: " + err.Error())
+	}
+
+	s.size = int64(len(remaining))
+	s.count = bytes.Count(remaining, []byte("\n"))
+
+	return sinkErr
+}
@@ -0,0 +1,151 @@
+package loggo_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+// flakySink is a Sink failing while it is down, counting the entries it is sent.
+type flakySink struct {
+	loggotest.Observer
+	down  bool
+	calls int
+}
+
+func (s *flakySink) WriteEntry(entry loggo.Entry) error {
+	s.calls++
+	if s.down {
+		return errors.New("connection refused")
+	}
+
+	return s.Observer.WriteEntry(entry)
+}
+
+func TestSpool(t *testing.T) {
+	dir := t.TempDir()
+	sink := &flakySink{down: true}
+	clock := loggotest.NewClock()
+
+	spool, err := loggo.NewSpool(sink, dir, loggo.WithSpoolClock(clock))
+	if err != nil {
+		t.Fatalf("NewSpool() error = %v", err)
+	}
+
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(&loggotest.Recorder{}), loggo.WithSink(spool))
+	logger.Info("first")
+	logger.Warn("second")
+
+	if len(sink.Entries()) != 0 || sink.calls != 1 {
+		t.Errorf("sink received %d entries in %d calls while down, want 0 in 1", len(sink.Entries()), sink.calls)
+	}
+
+	if spool.Len() == 0 {
+		t.Error("Spool.Len() = 0 while the sink is down, want > 0")
+	}
+
+	if err = spool.Flush(); err == nil {
+		t.Error("Spool.Flush() error = nil while the sink is down, want error")
+	}
+
+	sink.down = false
+	logger.Info("third")
+
+	if len(sink.Entries()) != 0 {
+		t.Errorf("sink received %d entries before the backoff delay, want 0", len(sink.Entries()))
+	}
+
+	clock.Advance(2 * time.Second)
+	logger.Info("fourth")
+
+	want := []string{"[INFO] first", "[WARN] second", "[INFO] third", "[INFO] fourth"}
+	if got := sink.All(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sink received %v, want %v", got, want)
+	}
+
+	if spool.Len() != 0 {
+		t.Errorf("Spool.Len() = %d after replay, want 0", spool.Len())
+	}
+
+	if _, err = os.Stat(filepath.Join(dir, "spool.jsonl")); !os.IsNotExist(err) {
+		t.Errorf("spool file exists after replay, error = %v", err)
+	}
+}
+
+func TestSpool_partialReplay(t *testing.T) {
+	dir := t.TempDir()
+	sink := &failAfterSink{limit: -1}
+
+	spool, err := loggo.NewSpool(sink, dir)
+	if err != nil {
+		t.Fatalf("NewSpool() error = %v", err)
+	}
+
+	for _, msg := range []string{"first", "second", "third"} {
+		if err = spool.WriteEntry(loggo.Entry{Message: msg}); err != nil {
+			t.Errorf("Spool.WriteEntry() error = %v", err)
+		}
+	}
+
+	sink.limit = 1
+
+	if err = spool.Flush(); err == nil {
+		t.Error("Spool.Flush() error = nil, want error")
+	}
+
+	// A new Spool on the same directory replays the entries left by the previous one.
+	sink.limit = 10
+
+	if _, err = loggo.NewSpool(sink, dir); err != nil {
+		t.Fatalf("NewSpool() error = %v", err)
+	}
+
+	if got, want := sink.messages, []string{"first", "second", "third"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sink received %v, want %v", got, want)
+	}
+}
+
+func TestSpool_full(t *testing.T) {
+	spool, err := loggo.NewSpool(&flakySink{down: true}, t.TempDir(), loggo.WithSpoolMaxSize(10))
+	if err != nil {
+		t.Fatalf("NewSpool() error = %v", err)
+	}
+
+	err = spool.WriteEntry(loggo.Entry{Message: "this entry does not fit"})
+	if err == nil || err.Error() != "error spooling entry: spool full" {
+		t.Errorf("Spool.WriteEntry() error = %v, want spool full", err)
+	}
+}
+
+func TestNewSpool_error(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(file, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := loggo.NewSpool(&flakySink{}, filepath.Join(file, "spool")); err == nil {
+		t.Error("NewSpool() error = nil, want error")
+	}
+}
+
+// failAfterSink is a Sink accepting a limited number of entries, failing all of them when the limit is negative.
+type failAfterSink struct {
+	limit    int
+	messages []string
+}
+
+func (s *failAfterSink) WriteEntry(entry loggo.Entry) error {
+	if len(s.messages) >= s.limit {
+		return errors.New("connection reset")
+	}
+
+	s.messages = append(s.messages, entry.Message)
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package loggo
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strings"
+)
+
+// StackFormat represents how the stack traces attached to the entries, such as the ones of ReportPanic, are rendered.
+// The zero value renders the full stack trace of debug.Stack.
+type StackFormat struct {
+	Depth   int  // Maximum number of frames rendered, the innermost ones, 0 for no limit
+	Fold    bool // Whether to collapse each run of runtime and standard library frames into a single line
+	Summary bool // Whether to render a single line of the functions, from the outermost, e.g. "main.main > app.run"
+}
+
+// stackFrame is a frame of a captured stack trace.
+type stackFrame struct {
+	function string
+	location string
+	standard bool
+}
+
+// stack returns the stack trace of the calling goroutine rendered in the stack format of the logger, skipping the
+// given number of frames above the caller of stack.
+func (l *Logger) stack(skip int) string {
+	if l.stackFormat == (StackFormat{}) {
+		return string(debug.Stack())
+	}
+
+	pcs := make([]uintptr, 64)
+	for {
+		n := runtime.Callers(skip+2, pcs)
+		if n < len(pcs) {
+			pcs = pcs[:n]
+			break
+		}
+
+		pcs = make([]uintptr, 2*len(pcs))
+	}
+
+	var frames []stackFrame
+
+	iter := runtime.CallersFrames(pcs)
+	for {
+		frame, more := iter.Next()
+		frames = append(frames, stackFrame{
+			function: frame.Function,
+			location: fmt.Sprintf("%s:%d", frame.File, frame.Line),
+			standard: isStandardFunction(frame.Function),
+		})
+
+		if !more {
+			break
+		}
+	}
+
+	return l.stackFormat.render(frames)
+}
+
+// render renders the frames, from the innermost, in the stack format.
+func (f StackFormat) render(frames []stackFrame) string {
+	truncated := 0
+	if f.Depth > 0 && len(frames) > f.Depth {
+		truncated = len(frames) - f.Depth
+		frames = frames[:f.Depth]
+	}
+
+	if f.Summary {
+		return f.summary(frames, truncated)
+	}
+
+	var b strings.Builder
+
+	for i := 0; i < len(frames); i++ {
+		if f.Fold && frames[i].standard {
+			j := i
+			for j < len(frames) && frames[j].standard {
+				j++
+			}
+
+			fmt.Fprintf(&b, "... %d standard library frames\n", j-i)
+			i = j - 1
+
+			continue
+		}
+
+		fmt.Fprintf(&b, "%s\n\t%s\n", frames[i].function, frames[i].location)
+	}
+
+	if truncated > 0 {
+		fmt.Fprintf(&b, "... %d more frames\n", truncated)
+	}
+
+	return b.String()
+}
+
+// summary renders the frames, from the innermost, as a single line of function names from the outermost.
+func (f StackFormat) summary(frames []stackFrame, truncated int) string {
+	var names []string
+
+	if truncated > 0 {
+		names = append(names, "...")
+	}
+
+	for i := len(frames) - 1; i >= 0; i-- {
+		if f.Fold && frames[i].standard {
+			if names == nil || names[len(names)-1] != "..." {
+				names = append(names, "...")
+			}
+
+			continue
+		}
+
+		names = append(names, shortFunctionName(frames[i].function))
+	}
+
+	return strings.Join(names, " > ")
+}
+
+// isStandardFunction reports whether a full function name belongs to the runtime or the standard library, whose
+// import paths have no dot in their first element, unlike the main package and the module paths.
+func isStandardFunction(function string) bool {
+	pkg := packagePath(function)
+	if pkg == "main" || pkg == "" {
+		return false
+	}
+
+	first, _, _ := strings.Cut(pkg, "/")
+
+	return !strings.Contains(first, ".")
+}
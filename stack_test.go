@@ -0,0 +1,80 @@
+package loggo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+func reportPanic(logger *loggo.Logger) {
+	defer func() {
+		logger.ReportPanic(recover())
+	}()
+
+	panic("boom")
+}
+
+func TestWithStackFormat(t *testing.T) {
+	type testCase struct {
+		name    string
+		format  loggo.StackFormat
+		want    []string
+		notWant []string
+	}
+
+	testCases := []testCase{
+		{
+			name:   "full",
+			format: loggo.StackFormat{},
+			want:   []string{"goroutine ", "runtime/debug.Stack", "loggo_test.reportPanic"},
+		},
+		{
+			name:    "fold",
+			format:  loggo.StackFormat{Fold: true},
+			want:    []string{"loggo_test.reportPanic\n\t", "standard library frames\n", "TestWithStackFormat"},
+			notWant: []string{"runtime.gopanic", "testing.tRunner"},
+		},
+		{
+			name:    "depth",
+			format:  loggo.StackFormat{Depth: 2},
+			want:    []string{"loggo_test.reportPanic.func1\n\t", "runtime.gopanic\n\t", "more frames\n"},
+			notWant: []string{"loggo_test.reportPanic\n"},
+		},
+		{
+			name:    "summary",
+			format:  loggo.StackFormat{Fold: true, Summary: true},
+			want:    []string{"... > loggo_test.TestWithStackFormat.func1 > loggo_test.reportPanic > ... > loggo_test.reportPanic.func1"},
+			notWant: []string{"\n"},
+		},
+		{
+			name:   "summary with depth",
+			format: loggo.StackFormat{Depth: 3, Summary: true},
+			want:   []string{"... > loggo_test.reportPanic > runtime.gopanic > loggo_test.reportPanic.func1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			observer := loggotest.NewObserver()
+			logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(&loggotest.Recorder{}), loggo.WithSink(observer),
+				loggo.WithStackFormat(tc.format))
+
+			reportPanic(logger)
+
+			stack, _ := observer.Entries()[0].Fields.Get("stack")
+			for _, want := range tc.want {
+				if !strings.Contains(stack.(string), want) {
+					t.Errorf("stack = %q, want it to contain %q", stack, want)
+				}
+			}
+
+			for _, notWant := range tc.notWant {
+				if strings.Contains(stack.(string), notWant) {
+					t.Errorf("stack = %q, want it not to contain %q", stack, notWant)
+				}
+			}
+		})
+	}
+}
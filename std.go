@@ -0,0 +1,46 @@
+package loggo
+
+import (
+	"bytes"
+	"log"
+)
+
+// stdCallerSkip is the number of frames of the log package between the caller of a *log.Logger and its writer.
+const stdCallerSkip = 2
+
+// stdWriter is the writer of a *log.Logger returned by NewStdLogger, logging each of its messages as an entry.
+type stdWriter struct {
+	logger *Logger
+	level  Level
+}
+
+// NewStdLogger returns a *log.Logger of the standard library logging its messages to the Logger at the given level,
+// for the libraries only accepting one, such as http.Server or database drivers. The *log.Logger has no prefix nor
+// flags, so the messages are logged without the date of the standard library, and its trailing newline is removed.
+// The caller of the entries is the caller of the *log.Logger.
+//
+// Parameters:
+//   - l: The Logger to log the messages to.
+//   - level: The log level of the messages.
+//
+// Returns:
+//   - The *log.Logger.
+//
+// Example:
+//
+//	server := &http.Server{Addr: ":8080", ErrorLog: loggo.NewStdLogger(logger, loggo.LevelError)}
+func NewStdLogger(l *Logger, level Level) *log.Logger {
+	c := l.clone()
+	c.callerSkip += stdCallerSkip
+
+	return log.New(&stdWriter{logger: c, level: level}, "", 0)
+}
+
+// Write logs the message written by the *log.Logger, without its trailing newline.
+func (w *stdWriter) Write(p []byte) (int, error) {
+	if err := w.logger.log(w.level, text(string(bytes.TrimSuffix(p, []byte("\n"))))); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
@@ -0,0 +1,28 @@
+package loggo_test
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestNewStdLogger(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithCallerFormat(loggo.CallerFormatBase),
+		loggo.WithTemplate("{{.Level}} {{.Caller}} {{.Message}}"))
+	std := loggo.NewStdLogger(logger, loggo.LevelWarn)
+
+	_, _, line, _ := runtime.Caller(0)
+	std.Printf("connection from %s reset", "10.0.0.1")
+	std.Println("multi\nline")
+	loggo.NewStdLogger(logger, loggo.LevelDebug).Print("discarded")
+
+	want := "WARN std_test.go:" + strconv.Itoa(line+1) + " connection from 10.0.0.1 reset\n" +
+		"WARN std_test.go:" + strconv.Itoa(line+2) + " multi\nline\n"
+	if w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
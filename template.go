@@ -0,0 +1,241 @@
+package loggo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"text/template"
+	"text/template/parse"
+)
+
+// LineEnding is the line ending appended to every log line.
+type LineEnding string
+
+// Available line endings.
+const (
+	// LineEndingLF is the Unix line ending, "\n". It is the default.
+	LineEndingLF LineEnding = "\n"
+	// LineEndingCRLF is the Windows line ending, "\r\n".
+	LineEndingCRLF LineEnding = "\r\n"
+	// LineEndingNone disables the line ending, for outputs that dictate their own framing.
+	LineEndingNone LineEnding = ""
+)
+
+// TemplateDefs are named templates, by name, that log message templates can include with the template action, e.g.
+// {{template "caller" .}}, to share snippets between templates.
+type TemplateDefs map[string]string
+
+// ValidateTemplate checks that a log message template is valid: it must parse, and every field it references must
+// exist in the template data (such as {{.Time}}, {{.Level}}, {{.Message}} and {{.Caller}}).
+// It is meant to be used, for example, in the tests of applications that configure their templates, catching typos
+// like {{.Mesage}} before they reach production.
+//
+// Parameters:
+//   - tmpl: The template string to validate.
+//
+// Returns:
+//   - An error describing the first problem found, nil if the template is valid.
+//
+// Example:
+//
+//	if err := loggo.ValidateTemplate("{{.Time}} {{.Mesage}}"); err != nil {
+//		log.Fatal(err) // unknown template field: Mesage
+//	}
+func ValidateTemplate(tmpl string) error {
+	t, err := parseTemplate(tmpl, nil)
+	if err != nil {
+		return err
+	}
+
+	return checkFields(t.Tree.Root)
+}
+
+// parseTemplate parses a log message template, with the template functions and the named
+// templates of the logger.
+func parseTemplate(tmpl string, logger *Logger) (*template.Template, error) {
+	t, err := template.New("log").Funcs(templateFuncs(nil, &boundTemplate{})).Parse(tmpl)
+	if err != nil {
+		return nil, errors.New("error parsing template: " + err.Error())
+	}
+
+	if logger == nil {
+		return t, nil
+	}
+
+	for name, def := range logger.templateDefs {
+		if _, err = t.New(name).Parse(def); err != nil {
+			return nil, errors.New("error parsing template " + name + ": " + err.Error())
+		}
+	}
+
+	return t, nil
+}
+
+// templateCache caches the compiled template of a logger, shared by the copies of the logger that render the same
+// template with the same context keys, such as the ones returned by Logger.If or Logger.AlsoTo.
+type templateCache struct {
+	compiled atomic.Pointer[compiledTemplate]
+}
+
+// compiledTemplate is a compiled template, with the source it was compiled from.
+type compiledTemplate struct {
+	source string
+	tmpl   *template.Template
+	err    error
+	bound  sync.Pool // Clones of the template bound to the context of the entry they render, see execute
+}
+
+// boundTemplate is a clone of a compiled template, whose ctx function looks up the context of the entry it renders.
+type boundTemplate struct {
+	tmpl *template.Template
+	ctx  context.Context // Context of the entry being rendered, nil between renders
+}
+
+// compiledTemplate returns the template of the logger, followed by its suffix, compiled once and cached until they
+// change.
+func (l *Logger) compiledTemplate() (*template.Template, error) {
+	c := l.compile()
+
+	return c.tmpl, c.err
+}
+
+// compile returns the compiled template of the logger, followed by its suffix, cached until they change.
+func (l *Logger) compile() *compiledTemplate {
+	source := l.template + l.suffix
+	if c := l.templates.compiled.Load(); c != nil && c.source == source {
+		return c
+	}
+
+	tmpl, err := parseTemplate(source, l)
+	c := &compiledTemplate{source: source, tmpl: tmpl, err: err}
+	l.templates.compiled.Store(c)
+
+	return c
+}
+
+// execute renders the template with the data, the ctx function looking up the context of its entry with the keys,
+// using a clone of the template bound to the entry, so concurrent renders do not share it.
+func (c *compiledTemplate) execute(w io.Writer, data templateData, keys map[string]any) error {
+	b, _ := c.bound.Get().(*boundTemplate)
+	if b == nil {
+		tmpl, err := c.tmpl.Clone()
+		if err != nil {
+			return err
+		}
+
+		b = &boundTemplate{}
+		b.tmpl = tmpl.Funcs(templateFuncs(keys, b))
+	}
+
+	b.ctx = data.Context
+	err := b.tmpl.Execute(w, data)
+	b.ctx = nil
+	c.bound.Put(b)
+
+	return err
+}
+
+// templateFuncs returns the functions available in log templates, bound to the template rendering the entry:
+//   - ctx: The value of the context of the entry for a name, e.g. {{ctx "request_id"}}, see WithContextKey.
+//   - json: The JSON encoding of a value, e.g. {"msg":{{json .Message}}}.
+func templateFuncs(keys map[string]any, bound *boundTemplate) template.FuncMap {
+	return template.FuncMap{
+		"ctx": func(name string) any {
+			return contextValue(bound.ctx, keys, name)
+		},
+		"json": jsonValue,
+	}
+}
+
+// jsonValue returns the JSON encoding of a value. Errors are encoded as their message, and the values that cannot be
+// encoded as their fmt.Sprint representation.
+func jsonValue(value any) string {
+	if err, ok := value.(error); ok {
+		value = err.Error()
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		b, _ = json.Marshal(fmt.Sprint(value))
+	}
+
+	return string(b)
+}
+
+// checkFields checks that the fields referenced by the node, with the template data as dot, exist.
+// The bodies of range and with actions are not checked, as dot is changed in them.
+func checkFields(node parse.Node) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+
+		for _, child := range n.Nodes {
+			if err := checkFields(child); err != nil {
+				return err
+			}
+		}
+	case *parse.ActionNode:
+		return checkFields(n.Pipe)
+	case *parse.TemplateNode:
+		return checkFields(n.Pipe)
+	case *parse.IfNode:
+		return checkBranch(&n.BranchNode, true)
+	case *parse.RangeNode:
+		return checkBranch(&n.BranchNode, false)
+	case *parse.WithNode:
+		return checkBranch(&n.BranchNode, false)
+	case *parse.PipeNode:
+		if n == nil {
+			return nil
+		}
+
+		for _, cmd := range n.Cmds {
+			for _, arg := range cmd.Args {
+				if err := checkFields(arg); err != nil {
+					return err
+				}
+			}
+		}
+	case *parse.ChainNode:
+		return checkFields(n.Node)
+	case *parse.FieldNode:
+		return checkField(n.Ident[0])
+	case *parse.VariableNode:
+		if n.Ident[0] == "$" && len(n.Ident) > 1 {
+			return checkField(n.Ident[1])
+		}
+	}
+
+	return nil
+}
+
+// checkBranch checks the fields referenced by an if, range or with action.
+func checkBranch(n *parse.BranchNode, sameDot bool) error {
+	if err := checkFields(n.Pipe); err != nil {
+		return err
+	}
+
+	if sameDot {
+		if err := checkFields(n.List); err != nil {
+			return err
+		}
+	}
+
+	return checkFields(n.ElseList)
+}
+
+// checkField checks that the template data has a field with the given name.
+func checkField(name string) error {
+	if _, ok := reflect.TypeOf(templateData{}).FieldByName(name); !ok {
+		return errors.New("unknown template field: " + name)
+	}
+
+	return nil
+}
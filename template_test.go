@@ -0,0 +1,158 @@
+package loggo_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestValidateTemplate(t *testing.T) {
+	type testCase struct {
+		name     string
+		template string
+		wantErr  string
+	}
+
+	testCases := []testCase{
+		{
+			name:     "default",
+			template: "{{.Time}} [{{printf \"%5s\" .Level}}]: {{.Message}}",
+		},
+		{
+			name:     "conditionals and variables",
+			template: "{{if .Caller}}{{$.Caller}} {{else}}{{.Time}} {{end}}{{.Message | printf \"%q\"}}",
+		},
+		{
+			name:     "range and with change dot",
+			template: "{{with .Message}}{{.Anything}}{{else}}{{.Level}}{{end}}{{range $i, $c := .Message}}{{$c}}{{end}}",
+		},
+		{
+			name:     "parse error",
+			template: "{{.Level",
+			wantErr:  "error parsing template: template: log:1: unclosed action",
+		},
+		{
+			name:     "typo",
+			template: "{{.Time}} {{.Mesage}}",
+			wantErr:  "unknown template field: Mesage",
+		},
+		{
+			name:     "typo in pipeline",
+			template: "{{printf \"%s\" (.Levle)}}",
+			wantErr:  "unknown template field: Levle",
+		},
+		{
+			name:     "typo in condition",
+			template: "{{if .Caler}}{{.Caller}}{{end}}",
+			wantErr:  "unknown template field: Caler",
+		},
+		{
+			name:     "typo in if body",
+			template: "{{if .Caller}}{{.Caler}}{{end}}",
+			wantErr:  "unknown template field: Caler",
+		},
+		{
+			name:     "typo in else",
+			template: "{{with .Caller}}{{.}}{{else}}{{$.Tme}}{{end}}",
+			wantErr:  "unknown template field: Tme",
+		},
+		{
+			name:     "typo in template call",
+			template: "{{define \"t\"}}{{.}}{{end}}{{template \"t\" .Mesage}}",
+			wantErr:  "unknown template field: Mesage",
+		},
+		{
+			name:     "typo in chain",
+			template: "{{(.Mesage).Len}}",
+			wantErr:  "unknown template field: Mesage",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := loggo.ValidateTemplate(tc.template)
+
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateTemplate() error = %q, want nil", err)
+				}
+
+				return
+			}
+
+			if err == nil || err.Error() != tc.wantErr {
+				t.Errorf("ValidateTemplate() error = %v, want %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestWithTemplateDefs(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTimeProvider(fakeNow),
+		loggo.WithTemplateDefs(loggo.TemplateDefs{"header": "{{.Time}} [{{.Level}}]", "footer": "(end)"}),
+		loggo.WithTemplateDefs(loggo.TemplateDefs{"footer": "({{.Level}})"}),
+		loggo.WithTemplate(`{{template "header" .}} {{.Message}}`),
+		loggo.WithSuffix(` {{template "footer" .}}`))
+
+	logger.Info("first")
+	logger.WithTemplateOnce(`{{template "header" .}} ===== {{.Message}} =====`).Warn("second")
+
+	want := "2022-01-25 00:00:00 [INFO] first (INFO)\n2022-01-25 00:00:00 [WARN] ===== second ===== (WARN)\n"
+	if w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+
+	broken := logger.Child(loggo.WithTemplateDefs(loggo.TemplateDefs{"header": "{{.Time"}))
+	if err := broken.LogE(loggo.LevelInfo, "third"); err == nil || !strings.HasPrefix(err.Error(), "error parsing template header:") {
+		t.Errorf("Logger.LogE() error = %v, want a parsing error of the header template", err)
+	}
+}
+
+func TestWithTemplateData(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w),
+		loggo.WithTemplateData("region", func(loggo.Entry) any { return "eu-west-1" }),
+		loggo.WithTemplateData("severe", func(entry loggo.Entry) any { return entry.Level >= loggo.LevelError }),
+		loggo.WithTemplate("[{{.Custom.region}}] {{if .Custom.severe}}!{{end}}{{.Message}}"))
+
+	logger.Info("first")
+	logger.Error("second")
+
+	if want := "[eu-west-1] first\n[eu-west-1] !second\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+
+	if err := loggo.ValidateTemplate("{{.Custom.region}}"); err != nil {
+		t.Errorf("ValidateTemplate() error = %v", err)
+	}
+}
+
+func TestTemplate_entry(t *testing.T) {
+	type contextKey struct{}
+
+	var got loggo.Entry
+
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTimeProvider(fakeNow),
+		loggo.WithContext(context.WithValue(context.Background(), contextKey{}, "req-1")),
+		loggo.WithSchemaVersion("2"),
+		loggo.WithTemplate("{{.Entry.Time.Unix}} {{.Entry.Level.Priority}} {{.Schema}} {{.Message}}"),
+		loggo.WithFilter(func(entry loggo.Entry) bool {
+			got = entry
+
+			return true
+		}))
+
+	logger.Warn("disk full")
+
+	if want := "1643068800 4 2 disk full\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+
+	if got.Context == nil || got.Context.Value(contextKey{}) != "req-1" {
+		t.Errorf("entry.Context = %v, want the context of the logger", got.Context)
+	}
+}
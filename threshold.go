@@ -0,0 +1,36 @@
+package loggo
+
+import (
+	"sync/atomic"
+)
+
+// newThreshold returns a threshold set to the level.
+func newThreshold(level Level) *atomic.Uint32 {
+	threshold := &atomic.Uint32{}
+	threshold.Store(uint32(level))
+
+	return threshold
+}
+
+// SetThreshold sets the minimum level of the messages logged by the Logger, e.g. to raise the verbosity of a running
+// process from an admin endpoint. Like the Threshold given to New, it is copied by the loggers derived from the Logger
+// afterwards, and leaves the ones derived before unchanged, unless the Logger has a dynamic level, whose derived
+// loggers follow its Threshold, see WithDynamicLevel. It is safe to call concurrently with logging: the threshold is
+// stored in an atomic, so the messages below it are discarded without taking any lock.
+//
+// Parameters:
+//   - level: The new Threshold.
+//
+// Example:
+//
+//	http.HandleFunc("/debug/verbose", func(w http.ResponseWriter, r *http.Request) {
+//		logger.SetThreshold(loggo.LevelDebug)
+//	})
+func (l *Logger) SetThreshold(level Level) {
+	l.threshold.Store(uint32(level))
+}
+
+// GetThreshold returns the minimum level of the messages logged by the Logger. It is safe for concurrent use.
+func (l *Logger) GetThreshold() Level {
+	return Level(l.threshold.Load())
+}
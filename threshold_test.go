@@ -0,0 +1,64 @@
+package loggo_test
+
+import (
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestLogger_SetThreshold(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Level}} {{.Message}}"))
+	before := logger.With("component", "db")
+	independent := logger.Child(loggo.WithThreshold(loggo.LevelError))
+
+	logger.Debug("discarded")
+	logger.SetThreshold(loggo.LevelDebug)
+	logger.Debug("logged")
+	before.Debug("derived before")
+	logger.With("component", "api").Debug("derived after")
+	independent.Warn("independent")
+
+	if got := logger.GetThreshold(); got != loggo.LevelDebug {
+		t.Errorf("GetThreshold() = %s, want DEBUG", got)
+	}
+
+	if got := before.GetThreshold(); got != loggo.LevelInfo {
+		t.Errorf("GetThreshold() of the logger derived before = %s, want INFO", got)
+	}
+
+	if got := independent.GetThreshold(); got != loggo.LevelError {
+		t.Errorf("GetThreshold() of the independent child = %s, want ERROR", got)
+	}
+
+	if want := "DEBUG logged\nDEBUG derived after\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
+
+func TestLogger_SetThreshold_concurrent(t *testing.T) {
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(io.Discard))
+
+	var wg sync.WaitGroup
+
+	for i := range 4 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := range 100 {
+				if i == 0 {
+					logger.SetThreshold(loggo.Level(j % 3))
+				}
+
+				logger.Debug("message")
+			}
+		}()
+	}
+
+	wg.Wait()
+}
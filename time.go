@@ -0,0 +1,139 @@
+package loggo
+
+import (
+	"strconv"
+	"time"
+)
+
+// Time format presets that can be used with WithTimeFormat.
+//
+// Besides the regular time layouts, TimeFormatEpochSeconds, TimeFormatEpochMillis and TimeFormatEpochNanos render the
+// time as a numeric Unix epoch timestamp.
+const (
+	// TimeFormatDefault is the default time format, with second precision.
+	TimeFormatDefault = "2006-01-02 15:04:05"
+	// TimeFormatMillis is the default time format with millisecond precision.
+	TimeFormatMillis = "2006-01-02 15:04:05.000"
+	// TimeFormatRFC3339 is the RFC 3339 time format, with second precision.
+	TimeFormatRFC3339 = time.RFC3339
+	// TimeFormatRFC3339Nano is the RFC 3339 time format, with nanosecond precision.
+	TimeFormatRFC3339Nano = time.RFC3339Nano
+	// TimeFormatKitchen is a short, human-friendly time format (e.g. "3:04PM").
+	TimeFormatKitchen = time.Kitchen
+	// TimeFormatEpochSeconds renders the time as the number of seconds since the Unix epoch.
+	TimeFormatEpochSeconds = "epoch_seconds"
+	// TimeFormatEpochMillis renders the time as the number of milliseconds since the Unix epoch.
+	TimeFormatEpochMillis = "epoch_millis"
+	// TimeFormatEpochNanos renders the time as the number of nanoseconds since the Unix epoch.
+	TimeFormatEpochNanos = "epoch_nanos"
+)
+
+// Clock provides the current time and the tickers used by a Logger, so timestamps and any periodic work (such as
+// flush intervals) can be controlled together, e.g. faked in tests.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// NewTicker returns a new Ticker that ticks with a period specified by the duration argument.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker is the interface of the tickers created by a Clock. It mirrors time.Ticker.
+type Ticker interface {
+	// C returns the channel on which the ticks are delivered.
+	C() <-chan time.Time
+	// Stop turns off the ticker.
+	Stop()
+}
+
+// systemClock is a Clock backed by a TimeProvider and the time package tickers.
+type systemClock struct {
+	now TimeProvider
+}
+
+// Now returns the time returned by the TimeProvider.
+func (c systemClock) Now() time.Time {
+	return c.now()
+}
+
+// NewTicker returns a Ticker backed by a time.Ticker.
+func (c systemClock) NewTicker(d time.Duration) Ticker {
+	return systemTicker{time.NewTicker(d)}
+}
+
+// systemTicker is a Ticker backed by a time.Ticker.
+type systemTicker struct {
+	ticker *time.Ticker
+}
+
+// C returns the channel on which the ticks are delivered.
+func (t systemTicker) C() <-chan time.Time {
+	return t.ticker.C
+}
+
+// Stop turns off the ticker.
+func (t systemTicker) Stop() {
+	t.ticker.Stop()
+}
+
+// fixedClock is a Clock whose current time is fixed, delegating its tickers to another Clock.
+type fixedClock struct {
+	Clock
+	now time.Time
+}
+
+// Now returns the fixed time.
+func (c fixedClock) Now() time.Time {
+	return c.now
+}
+
+// getTime returns the current time of the logger, in its configured location.
+func getTime(logger *Logger) time.Time {
+	t := logger.clock.Now()
+	if logger.location != nil {
+		t = t.In(logger.location)
+	}
+
+	return t
+}
+
+// formatTime formats the time with the given format, handling the epoch presets.
+func formatTime(t time.Time, format string) string {
+	if epoch, ok := epochTime(t, format); ok {
+		return strconv.FormatInt(epoch, 10)
+	}
+
+	return t.Format(format)
+}
+
+// epochTime returns the time as a Unix epoch number if the format is one of the epoch presets.
+func epochTime(t time.Time, format string) (int64, bool) {
+	switch format {
+	case TimeFormatEpochSeconds:
+		return t.Unix(), true
+	case TimeFormatEpochMillis:
+		return t.UnixMilli(), true
+	case TimeFormatEpochNanos:
+		return t.UnixNano(), true
+	default:
+		return 0, false
+	}
+}
+
+// TimeBucket represents how the time of the entries is floored to a window, e.g. to the minute, rendered by
+// {{.TimeBucket}} and optionally attached as a field, for the jobs grouping the entries by period. The zero value
+// disables it.
+type TimeBucket struct {
+	Window time.Duration // Duration of the window the time is floored to, such as time.Minute or 5 * time.Minute
+	Field  string        // Key of the field holding the bucket attached to each entry, empty to only render it
+}
+
+// bucket returns the time floored to the window of the time bucket of the logger, in its time format. Epoch formats
+// return the number instead of its text, so the encoders render it as a number.
+func (l *Logger) bucket(t time.Time) any {
+	floored := t.Truncate(l.timeBucket.Window)
+	if epoch, ok := epochTime(floored, l.timeFormat); ok {
+		return epoch
+	}
+
+	return floored.Format(l.timeFormat)
+}
@@ -0,0 +1,19 @@
+package loggo
+
+import (
+	"runtime/trace"
+)
+
+// traceEntries emits the entries at or above the trace level of the logger as runtime/trace user log events, with
+// the level as category, when tracing is enabled.
+func (l *Logger) traceEntries(entries []Entry) {
+	if !l.trace || !trace.IsEnabled() {
+		return
+	}
+
+	for _, entry := range entries {
+		if entry.Level.Rank() >= l.traceLevel.Rank() {
+			trace.Log(l.Context, entry.Level.String(), entry.Message)
+		}
+	}
+}
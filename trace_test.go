@@ -0,0 +1,32 @@
+package loggo_test
+
+import (
+	"bytes"
+	"runtime/trace"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+	"github.com/hvpaiva/loggo/loggotest"
+)
+
+func TestWithTrace(t *testing.T) {
+	var out bytes.Buffer
+
+	if err := trace.Start(&out); err != nil {
+		t.Skipf("trace.Start() error = %v", err)
+	}
+
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(&loggotest.Recorder{}), loggo.WithTrace(loggo.LevelWarn))
+	logger.Info("not traced")
+	logger.Warn("disk almost full")
+
+	trace.Stop()
+
+	if !bytes.Contains(out.Bytes(), []byte("disk almost full")) {
+		t.Error("execution trace does not contain the warning")
+	}
+
+	if bytes.Contains(out.Bytes(), []byte("not traced")) {
+		t.Error("execution trace contains the entry below the trace level")
+	}
+}
@@ -0,0 +1,111 @@
+package loggo
+
+import (
+	"sync"
+)
+
+// Transformer is a function transforming an entry before it is rendered, such as redacting, enriching or sampling it.
+// It returns the transformed entry, and false to discard it.
+type Transformer func(entry Entry) (Entry, bool)
+
+// transformChain is the ordered list of the named transformers of a logger. The chain of a child logger links to the
+// chain of its parent, whose transformers run first, so the changes to the parent apply to its children.
+type transformChain struct {
+	mu     sync.RWMutex
+	parent *transformChain
+	names  []string
+	funcs  []Transformer
+}
+
+// transform runs the transformers of the chain, and of its parents, on the entry, until one discards it.
+func (c *transformChain) transform(entry Entry) (Entry, bool) {
+	if c.parent != nil {
+		var ok bool
+		if entry, ok = c.parent.transform(entry); !ok {
+			return entry, false
+		}
+	}
+
+	c.mu.RLock()
+	funcs := c.funcs
+	c.mu.RUnlock()
+
+	for _, t := range funcs {
+		var ok bool
+		if entry, ok = t(entry); !ok {
+			return entry, false
+		}
+	}
+
+	return entry, true
+}
+
+// add adds a named transformer at the end of the chain, or replaces the transformer of the same name in place.
+func (c *transformChain) add(name string, t Transformer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, n := range c.names {
+		if n == name {
+			funcs := append([]Transformer{}, c.funcs...)
+			funcs[i] = t
+			c.funcs = funcs
+
+			return
+		}
+	}
+
+	c.names = append(c.names[:len(c.names):len(c.names)], name)
+	c.funcs = append(c.funcs[:len(c.funcs):len(c.funcs)], t)
+}
+
+// remove removes the named transformer from the chain, reporting whether it was found.
+func (c *transformChain) remove(name string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, n := range c.names {
+		if n == name {
+			c.names = append(c.names[:i:i], c.names[i+1:]...)
+			c.funcs = append(c.funcs[:i:i], c.funcs[i+1:]...)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// AddTransformer adds a named Transformer at the end of the transformers of the Logger, or replaces the transformer of
+// the same name in place. Transformers run in order on the entries accepted by the filters, before they are rendered,
+// and apply to the children of the Logger, after their parent's ones. It is safe to call while logging.
+//
+// Parameters:
+//   - name: The name of the transformer, to replace or remove it.
+//   - t: The Transformer to add.
+//
+// Example:
+//
+//	logger.AddTransformer("redact", func(entry loggo.Entry) (loggo.Entry, bool) {
+//		entry.Message = cardNumbers.ReplaceAllString(entry.Message, "****")
+//		return entry, true
+//	})
+func (l *Logger) AddTransformer(name string, t Transformer) {
+	l.transforms.add(name, t)
+}
+
+// RemoveTransformer removes the named Transformer from the transformers of the Logger. The transformers of its parent
+// are not affected.
+//
+// Parameters:
+//   - name: The name of the transformer.
+//
+// Returns:
+//   - true if the transformer was found and removed, false otherwise.
+//
+// Example:
+//
+//	logger.RemoveTransformer("redact")
+func (l *Logger) RemoveTransformer(name string) bool {
+	return l.transforms.remove(name)
+}
@@ -0,0 +1,45 @@
+package loggo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestLogger_AddTransformer(t *testing.T) {
+	w := &strings.Builder{}
+	upper := func(entry loggo.Entry) (loggo.Entry, bool) {
+		entry.Message = strings.ToUpper(entry.Message)
+		return entry, true
+	}
+	sample := func(entry loggo.Entry) (loggo.Entry, bool) {
+		return entry, !strings.HasPrefix(entry.Message, "NOISY")
+	}
+	region := func(entry loggo.Entry) (loggo.Entry, bool) {
+		entry.Fields = append(entry.Fields, loggo.F("region", "eu"))
+		return entry, true
+	}
+
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Message}}{{with .Fields}} {{.}}{{end}}"),
+		loggo.WithTransformer("upper", upper), loggo.WithTransformer("sample", sample))
+	child := logger.Child(loggo.WithTransformer("region", region))
+
+	logger.Info("first")
+	logger.Info("noisy")
+	child.Info("child")
+
+	logger.RemoveTransformer("upper")
+	child.Info("noisy child")
+	logger.AddTransformer("sample", func(entry loggo.Entry) (loggo.Entry, bool) { return entry, true })
+	child.Info("noisy again")
+
+	if child.RemoveTransformer("sample") {
+		t.Error("Logger.RemoveTransformer() of a parent transformer = true, want false")
+	}
+
+	want := "FIRST\nCHILD region=eu\nnoisy child region=eu\nnoisy again region=eu\n"
+	if w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
@@ -0,0 +1,116 @@
+package loggo
+
+import (
+	"bytes"
+	"sync"
+)
+
+// maxLineSize is the maximum size, in bytes, of a line read by an Importer or buffered by a LineWriter, as in the
+// loggo command.
+const maxLineSize = 1 << 20
+
+// lineWriterCallerSkip is the number of frames of a LineWriter between its Write and Close methods and the Logger.
+const lineWriterCallerSkip = 1
+
+// LineWriter is an io.Writer logging each line written to it as an entry of a Logger, returned by Logger.Writer.
+// It is safe for concurrent use.
+type LineWriter struct {
+	mu      sync.Mutex
+	logger  *Logger
+	level   Level
+	partial []byte
+}
+
+// Writer returns a LineWriter logging each line written to it at the given level, for the code only writing to an
+// io.Writer, such as the Stdout and Stderr of an exec.Cmd. The lines are logged without their line ending, and empty
+// lines are skipped. A line is only logged once its newline is written, so the last line written without a newline is
+// logged when the LineWriter is closed. A line is logged in parts of 1 MiB, so the LineWriter never buffers more than
+// that without a newline. The caller of the entries is the caller of Write or Close, e.g. io.Copy.
+//
+// Parameters:
+//   - level: The log level of the lines.
+//
+// Returns:
+//   - A pointer to the LineWriter.
+//
+// Example:
+//
+//	stderr := logger.Writer(loggo.LevelWarn)
+//	defer stderr.Close()
+//
+//	cmd := exec.Command("make", "build")
+//	cmd.Stdout = logger.Writer(loggo.LevelDebug)
+//	cmd.Stderr = stderr
+func (l *Logger) Writer(level Level) *LineWriter {
+	c := l.clone()
+	c.callerSkip += lineWriterCallerSkip
+
+	return &LineWriter{logger: c, level: level}
+}
+
+// Write logs the complete lines of p, keeping the last line until its newline is written, or until it reaches 1 MiB.
+//
+// Returns:
+//   - The number of bytes of p, consumed even if the lines were discarded.
+//   - The first error of the Logger while logging the lines, nil otherwise.
+func (w *LineWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var err error
+
+	rest := p
+	for {
+		i := bytes.IndexByte(rest, '\n')
+		if i < 0 {
+			break
+		}
+
+		line := rest[:i]
+		if len(w.partial) > 0 {
+			line = append(w.partial, line...)
+			w.partial = w.partial[:0]
+		}
+
+		if lineErr := w.logLine(line); err == nil {
+			err = lineErr
+		}
+
+		rest = rest[i+1:]
+	}
+
+	w.partial = append(w.partial, rest...)
+	for len(w.partial) >= maxLineSize {
+		if lineErr := w.logLine(w.partial[:maxLineSize]); err == nil {
+			err = lineErr
+		}
+
+		w.partial = append(w.partial[:0], w.partial[maxLineSize:]...)
+	}
+
+	return len(p), err
+}
+
+// Close logs the last line written without a newline, if any. The LineWriter can still be written to afterward.
+//
+// Returns:
+//   - An error if the Logger failed to log the line, nil otherwise.
+func (w *LineWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	line := w.partial
+	w.partial = nil
+
+	return w.logLine(line)
+}
+
+// logLine logs the line without its carriage return, unless it is empty. The lock must be held.
+func (w *LineWriter) logLine(line []byte) error {
+	line = bytes.TrimSuffix(line, []byte("\r"))
+	if len(line) == 0 {
+		return nil
+	}
+
+	return w.logger.log(w.level, text(string(line)))
+}
@@ -0,0 +1,116 @@
+package loggo_test
+
+import (
+	"io"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/loggo"
+)
+
+func TestLogger_Writer(t *testing.T) {
+	type testCase struct {
+		name   string
+		writes []string
+		close  bool
+		want   string
+	}
+
+	testCases := []testCase{
+		{
+			name:   "one line per write",
+			writes: []string{"first\n", "second\n"},
+			want:   "WARN first\nWARN second\n",
+		},
+		{
+			name:   "several lines in a write",
+			writes: []string{"first\r\nsecond\n\nthird\n"},
+			want:   "WARN first\nWARN second\nWARN third\n",
+		},
+		{
+			name:   "line split across writes",
+			writes: []string{"fir", "st\nsec", "ond\n"},
+			want:   "WARN first\nWARN second\n",
+		},
+		{
+			name:   "partial line kept until close",
+			writes: []string{"first\nlast"},
+			want:   "WARN first\n",
+		},
+		{
+			name:   "partial line logged on close",
+			writes: []string{"first\nlast"},
+			close:  true,
+			want:   "WARN first\nWARN last\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithTemplate("{{.Level}} {{.Message}}"))
+
+			lw := logger.Writer(loggo.LevelWarn)
+			for _, s := range tc.writes {
+				n, err := io.WriteString(lw, s)
+				if err != nil || n != len(s) {
+					t.Fatalf("Write(%q) = %d, %v, want %d, nil", s, n, err, len(s))
+				}
+			}
+
+			if tc.close {
+				if err := lw.Close(); err != nil {
+					t.Fatalf("Close() = %v", err)
+				}
+			}
+
+			if w.String() != tc.want {
+				t.Errorf("output = %q, want %q", w.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestLogger_Writer_maxLine(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithMaxSize(2<<20),
+		loggo.WithTemplate("{{.Level}} {{len .Message}}"))
+
+	lw := logger.Writer(loggo.LevelWarn)
+	for _, s := range []string{strings.Repeat("a", 1<<20-1), "aaaa"} {
+		if _, err := io.WriteString(lw, s); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+
+	if want := "WARN 1048576\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+
+	if err := lw.Close(); err != nil {
+		t.Fatalf("Close() = %v", err)
+	}
+
+	if want := "WARN 1048576\nWARN 3\n"; w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}
+
+func TestLogger_Writer_caller(t *testing.T) {
+	w := &strings.Builder{}
+	logger := loggo.New(loggo.LevelInfo, loggo.WithOutput(w), loggo.WithCallerFormat(loggo.CallerFormatBase),
+		loggo.WithTemplate("{{.Level}} {{.Caller}} {{.Message}}"))
+	lw := logger.Writer(loggo.LevelWarn)
+
+	_, _, line, _ := runtime.Caller(0)
+	_, _ = lw.Write([]byte("first\nlast"))
+	_ = lw.Close()
+
+	want := "WARN writer_test.go:" + strconv.Itoa(line+1) + " first\n" +
+		"WARN writer_test.go:" + strconv.Itoa(line+2) + " last\n"
+	if w.String() != want {
+		t.Errorf("output = %q, want %q", w.String(), want)
+	}
+}